@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE tokens
+(
+    id              serial PRIMARY KEY,
+    uid             varchar(30)  NOT NULL UNIQUE CHECK (uid <> ''),
+    name            varchar(255) NOT NULL CHECK (name <> ''),
+    -- sha256 of the secret, the secret itself is only shown once when the token is created
+    token_hash      varchar(64)  NOT NULL UNIQUE,
+
+    -- kubernetes identity the token acts as
+    username        text         NOT NULL CHECK (username <> ''),
+    groups          text[]       NOT NULL DEFAULT '{}',
+
+    -- scope
+    read_only       boolean      NOT NULL DEFAULT false,
+    namespace       varchar(30)  NOT NULL DEFAULT '',
+
+    expires_at      timestamp,
+    last_used_at    timestamp,
+    revoked_at      timestamp,
+
+    -- auditing info
+    created_at      timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE INDEX tokens_username_idx ON tokens (username);
+
+-- +goose Down
+DROP TABLE tokens;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE audit_events
+(
+    id              bigserial PRIMARY KEY,
+    -- who made the request
+    username        text         NOT NULL DEFAULT '',
+    -- what was requested, e.g. /api.WorkspaceService/DeleteWorkspace
+    method          varchar(255) NOT NULL,
+    namespace       varchar(255) NOT NULL DEFAULT '',
+    resource_uid    varchar(255) NOT NULL DEFAULT '',
+    -- request with sensitive values redacted
+    request         jsonb        NOT NULL DEFAULT '{}',
+    -- grpc status code of the result
+    code            varchar(30)  NOT NULL,
+    latency_ms      bigint       NOT NULL DEFAULT 0,
+
+    created_at      timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+CREATE INDEX audit_events_namespace_created_at_idx ON audit_events (namespace, created_at);
+CREATE INDEX audit_events_username_idx ON audit_events (username);
+CREATE INDEX audit_events_resource_uid_idx ON audit_events (resource_uid);
+
+-- +goose Down
+DROP TABLE audit_events;
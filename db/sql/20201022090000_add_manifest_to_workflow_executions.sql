@@ -0,0 +1,6 @@
+-- +goose Up
+-- The Argo workflow, including its status, saved when the execution finishes so it can be inspected after Argo garbage collects it
+ALTER TABLE workflow_executions ADD COLUMN manifest TEXT;
+
+-- +goose Down
+ALTER TABLE workflow_executions DROP COLUMN manifest;
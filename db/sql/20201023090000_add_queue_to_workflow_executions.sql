@@ -0,0 +1,12 @@
+-- +goose Up
+-- Executions over the concurrency limits are stored in the Queued phase with the workflow to submit once they are dispatched
+ALTER TABLE workflow_executions ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE workflow_executions ADD COLUMN queued_at TIMESTAMP;
+ALTER TABLE workflow_executions ADD COLUMN queued_manifest TEXT;
+CREATE INDEX workflow_executions_queued_idx ON workflow_executions (priority DESC, queued_at, id) WHERE phase = 'Queued';
+
+-- +goose Down
+DROP INDEX workflow_executions_queued_idx;
+ALTER TABLE workflow_executions DROP COLUMN queued_manifest;
+ALTER TABLE workflow_executions DROP COLUMN queued_at;
+ALTER TABLE workflow_executions DROP COLUMN priority;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE workflow_execution_metrics
+(
+    id                    bigserial        PRIMARY KEY,
+    workflow_execution_id integer          NOT NULL REFERENCES workflow_executions ON DELETE CASCADE,
+    -- the node of the step that logged the metric, argo names pods after their node
+    node_id               varchar(255)     NOT NULL,
+    name                  varchar(255)     NOT NULL,
+    -- the training step, epoch or other index the value belongs to
+    step                  bigint           NOT NULL,
+    value                 double precision NOT NULL,
+
+    created_at            timestamp        NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE INDEX workflow_execution_metrics_series_idx ON workflow_execution_metrics (workflow_execution_id, node_id, name, step);
+
+-- +goose Down
+DROP TABLE workflow_execution_metrics;
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE sweeps
+(
+    id                           serial       PRIMARY KEY,
+    uid                          varchar(30)  NOT NULL CHECK (uid <> ''),
+    name                         text         NOT NULL CHECK (name <> ''),
+    namespace                    varchar(30)  NOT NULL,
+    workflow_template_version_id integer      NOT NULL REFERENCES workflow_template_versions ON DELETE CASCADE,
+    -- the search spaces, parallelism and objective
+    spec                         jsonb        NOT NULL,
+    -- the parameters of each run, generated from the spec when the sweep is created
+    runs                         jsonb        NOT NULL,
+    -- the number of runs that have been launched
+    launched                     integer      NOT NULL DEFAULT 0,
+    phase                        varchar(50)  NOT NULL,
+    message                      text         NOT NULL DEFAULT '',
+    best_workflow_execution_uid  varchar(255) DEFAULT NULL,
+    best_value                   double precision DEFAULT NULL,
+
+    created_at                   timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc'),
+    modified_at                  timestamp    DEFAULT NULL,
+    finished_at                  timestamp    DEFAULT NULL,
+
+    UNIQUE (namespace, uid)
+);
+
+CREATE INDEX sweeps_phase_idx ON sweeps (phase);
+
+-- +goose Down
+DROP TABLE sweeps;
@@ -0,0 +1,50 @@
+-- +goose Up
+CREATE TABLE notification_subscriptions
+(
+    id              serial PRIMARY KEY,
+    uid             varchar(30)  NOT NULL UNIQUE CHECK (uid <> ''),
+    namespace       varchar(30)  NOT NULL,
+    name            varchar(255) NOT NULL CHECK (name <> ''),
+    url             text         NOT NULL CHECK (url <> ''),
+    -- key of the hmac signature sent with each delivery
+    secret          text         NOT NULL,
+    -- json or slack
+    format          varchar(30)  NOT NULL,
+    -- the event types that are delivered, e.g. workflow_execution.failed
+    events          text[]       NOT NULL DEFAULT '{}',
+    -- only events of resources with all of these labels are delivered
+    labels          jsonb        NOT NULL DEFAULT '{}',
+
+    created_at      timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc'),
+    modified_at     timestamp
+);
+
+CREATE INDEX notification_subscriptions_namespace_idx ON notification_subscriptions (namespace);
+
+CREATE TABLE notification_deliveries
+(
+    id                bigserial PRIMARY KEY,
+    uid               varchar(30)  NOT NULL UNIQUE CHECK (uid <> ''),
+    subscription_id   integer      NOT NULL REFERENCES notification_subscriptions ON DELETE CASCADE,
+    event             varchar(255) NOT NULL,
+    resource_uid      varchar(255) NOT NULL,
+    -- the body that is sent, it is signed as is
+    payload           text         NOT NULL,
+    -- Pending, Delivered or Failed
+    status            varchar(30)  NOT NULL,
+    attempts          integer      NOT NULL DEFAULT 0,
+    -- http status code and error of the last attempt
+    response_code     integer,
+    error             text         NOT NULL DEFAULT '',
+    next_attempt_at   timestamp,
+
+    created_at        timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc'),
+    delivered_at      timestamp
+);
+
+CREATE INDEX notification_deliveries_subscription_id_created_at_idx ON notification_deliveries (subscription_id, created_at);
+CREATE INDEX notification_deliveries_pending_idx ON notification_deliveries (next_attempt_at) WHERE status = 'Pending';
+
+-- +goose Down
+DROP TABLE notification_deliveries;
+DROP TABLE notification_subscriptions;
@@ -0,0 +1,41 @@
+-- +goose Up
+CREATE TABLE triggers
+(
+    id                           serial       PRIMARY KEY,
+    uid                          varchar(30)  NOT NULL UNIQUE CHECK (uid <> ''),
+    namespace                    varchar(30)  NOT NULL,
+    name                         varchar(255) NOT NULL CHECK (name <> ''),
+    workflow_template_version_id integer      NOT NULL REFERENCES workflow_template_versions ON DELETE CASCADE,
+    -- random value that the url token and signing key are derived from with the system hmac key, nothing secret is stored
+    nonce                        varchar(64)  NOT NULL,
+    -- maps JSONPath expressions of the payload to workflow template parameters
+    parameters                   jsonb        NOT NULL DEFAULT '[]',
+    -- labels of the workflow executions the trigger creates
+    labels                       jsonb        NOT NULL DEFAULT '{}',
+
+    created_at                   timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc'),
+    modified_at                  timestamp
+);
+
+CREATE INDEX triggers_namespace_idx ON triggers (namespace);
+
+CREATE TABLE trigger_invocations
+(
+    id                      bigserial    PRIMARY KEY,
+    trigger_id              integer      NOT NULL REFERENCES triggers ON DELETE CASCADE,
+    -- Succeeded, Failed or Rejected
+    status                  varchar(30)  NOT NULL,
+    error                   text         NOT NULL DEFAULT '',
+    -- the payload is not kept for rejected invocations
+    payload                 text         NOT NULL DEFAULT '',
+    parameters              jsonb        NOT NULL DEFAULT '[]',
+    workflow_execution_uid  varchar(255),
+
+    created_at              timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE INDEX trigger_invocations_trigger_id_created_at_idx ON trigger_invocations (trigger_id, created_at);
+
+-- +goose Down
+DROP TABLE trigger_invocations;
+DROP TABLE triggers;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- webhook triggers are invoked through their url, artifact triggers poll the artifact repository of their namespace
+ALTER TABLE triggers ADD COLUMN type varchar(30) NOT NULL DEFAULT 'webhook';
+ALTER TABLE triggers ADD COLUMN artifact_prefix text NOT NULL DEFAULT '';
+-- when the artifact repository was last listed, the objects found by the first listing don't launch anything
+ALTER TABLE triggers ADD COLUMN polled_at timestamp;
+
+-- the objects an artifact trigger has seen, an object is new if its key and etag have not been seen
+CREATE TABLE trigger_artifact_objects
+(
+    id                      bigserial    PRIMARY KEY,
+    trigger_id              integer      NOT NULL REFERENCES triggers ON DELETE CASCADE,
+    key                     text         NOT NULL,
+    etag                    varchar(255) NOT NULL DEFAULT '',
+    workflow_execution_uid  varchar(255),
+
+    created_at              timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc'),
+
+    UNIQUE (trigger_id, key, etag)
+);
+
+CREATE INDEX triggers_type_idx ON triggers (type);
+
+-- +goose Down
+DROP TABLE trigger_artifact_objects;
+DROP INDEX triggers_type_idx;
+ALTER TABLE triggers DROP COLUMN polled_at;
+ALTER TABLE triggers DROP COLUMN artifact_prefix;
+ALTER TABLE triggers DROP COLUMN type;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- id of the transaction that last changed the workspace, watches page through changes with it
+ALTER TABLE workspaces ADD COLUMN change_txid bigint NOT NULL DEFAULT txid_current();
+
+-- +goose StatementBegin
+CREATE FUNCTION set_workspace_change_txid() RETURNS trigger AS $$
+BEGIN
+    NEW.change_txid := txid_current();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER workspaces_change_txid BEFORE INSERT OR UPDATE ON workspaces
+    FOR EACH ROW EXECUTE PROCEDURE set_workspace_change_txid();
+
+CREATE INDEX workspaces_namespace_change_txid_idx ON workspaces (namespace, change_txid, id);
+
+-- +goose Down
+DROP INDEX workspaces_namespace_change_txid_idx;
+DROP TRIGGER workspaces_change_txid ON workspaces;
+DROP FUNCTION set_workspace_change_txid();
+ALTER TABLE workspaces DROP COLUMN change_txid;
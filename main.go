@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"flag"
 	"fmt"
 	migrations "github.com/onepanelio/core/db/go"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
+	"io/ioutil"
 	corev1 "k8s.io/api/core/v1"
 	apiv1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -18,6 +21,7 @@ import (
 	"net/http"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gorilla/handlers"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -29,22 +33,29 @@ import (
 	v1 "github.com/onepanelio/core/pkg"
 	"github.com/onepanelio/core/pkg/util/env"
 	"github.com/onepanelio/core/server"
+	"github.com/onepanelio/core/server/audit"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/pressly/goose"
 	log "github.com/sirupsen/logrus"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc"
 )
 
 var (
 	rpcPort      = flag.String("rpc-port", ":8887", "RPC Port")
 	httpPort     = flag.String("http-port", ":8888", "RPC Port")
+	metricsPort  = flag.String("metrics-port", "", "Port that serves expvar metrics on /debug/vars, disabled if empty")
 	recoveryFunc grpc_recovery.RecoveryHandlerFunc
 )
 
 func main() {
 	flag.Parse()
 
+	if *metricsPort != "" {
+		go startMetricsServer()
+	}
+
 	// stopCh is used to indicate when the RPC server should reload.
 	// We do this when the configuration has been changed, so the server has the latest configuration
 	stopCh := make(chan struct{})
@@ -58,6 +69,7 @@ func main() {
 
 		go watchConfigmapChanges(client, "onepanel", stopCh, func(configMap *corev1.ConfigMap) error {
 			log.Printf("Configmap changed")
+			auth.InvalidateAuthorizationCache()
 			stopCh <- struct{}{}
 
 			return nil
@@ -88,10 +100,13 @@ func main() {
 				db.Close()
 			}
 
-			s := startRPCServer(v1.NewDB(db), kubeConfig, sysConfig, stopCh)
+			database := v1.NewDB(db)
+			s := startRPCServer(database, kubeConfig, sysConfig, stopCh)
+			jobsStopCh := startBackgroundJobs(database, kubeConfig, sysConfig)
 
 			<-stopCh
 
+			close(jobsStopCh)
 			s.Stop()
 			if err := db.Close(); err != nil {
 				log.Printf("[error] closing db connection")
@@ -129,12 +144,14 @@ func startRPCServer(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig,
 		grpc_middleware.ChainUnaryServer(
 			grpc_logrus.UnaryServerInterceptor(logEntry),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpts...),
-			auth.UnaryInterceptor(kubeConfig, db, sysConfig)),
+			auth.UnaryInterceptor(kubeConfig, db, sysConfig),
+			audit.UnaryInterceptor(db)),
 	), grpc.StreamInterceptor(
 		grpc_middleware.ChainStreamServer(
 			grpc_logrus.StreamServerInterceptor(logEntry),
 			grpc_recovery.StreamServerInterceptor(recoveryOpts...),
-			auth.StreamingInterceptor(kubeConfig, db, sysConfig)),
+			auth.StreamingInterceptor(kubeConfig, db, sysConfig),
+			audit.StreamInterceptor(db)),
 	), grpc.MaxRecvMsgSize(math.MaxInt64), grpc.MaxSendMsgSize(math.MaxInt64))
 	api.RegisterWorkflowTemplateServiceServer(s, server.NewWorkflowTemplateServer())
 	api.RegisterCronWorkflowServiceServer(s, server.NewCronWorkflowServer())
@@ -147,6 +164,12 @@ func startRPCServer(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig,
 	api.RegisterWorkspaceServiceServer(s, server.NewWorkspaceServer())
 	api.RegisterConfigServiceServer(s, server.NewConfigServer())
 	api.RegisterServiceServiceServer(s, server.NewServiceServer())
+	api.RegisterTokenServiceServer(s, server.NewTokenServer())
+	api.RegisterAuditServiceServer(s, server.NewAuditServer())
+	api.RegisterSweepServiceServer(s, server.NewSweepServer())
+	api.RegisterNotificationServiceServer(s, server.NewNotificationServer())
+	api.RegisterTriggerServiceServer(s, server.NewTriggerServer())
+	api.RegisterFileServiceServer(s, server.NewFileServer())
 
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -167,7 +190,12 @@ func startHTTPProxy() {
 
 	// Register gRPC server endpoint
 	// Note: Make sure the gRPC server is running properly and accessible
-	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(customHeaderMatcher))
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
+		// HTTPBodyMarshaler writes google.api.HttpBody responses, like log downloads, as is,
+		// rawBodyMarshaler reads google.api.HttpBody requests, like trigger payloads, as is
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &rawBodyMarshaler{&runtime.HTTPBodyMarshaler{Marshaler: &runtime.JSONPb{OrigName: true}}}),
+	)
 	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt64),
 		grpc.MaxCallRecvMsgSize(math.MaxInt64))}
 
@@ -182,6 +210,15 @@ func startHTTPProxy() {
 	registerHandler(api.RegisterWorkspaceServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterConfigServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterServiceServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterTokenServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterAuditServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterSweepServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterNotificationServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterTriggerServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterFileServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(server.RegisterFileUploadHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(server.RegisterArtifactDownloadHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(server.RegisterLogDownloadHandlerFromEndpoint, ctx, mux, endpoint, opts)
 
 	log.Printf("Starting HTTP proxy on port %v", *httpPort)
 
@@ -205,6 +242,84 @@ func startHTTPProxy() {
 	}
 }
 
+// startBackgroundJobs starts the jobs that run with the server's own credentials, such as dispatching queued workflow executions,
+// applying the retention policies, launching the runs of sweeps, sending notifications, polling artifact triggers
+// and keeping the status of workflow executions in sync with argo.
+// The jobs stop when the returned channel is closed.
+func startBackgroundJobs(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig) chan struct{} {
+	jobsStopCh := make(chan struct{})
+
+	client, err := v1.NewClient(kubeConfig, db, sysConfig)
+	if err != nil {
+		log.Fatalf("Failed to create background jobs client: %v", err)
+	}
+
+	go runPeriodically(v1.ExecutionQueueDispatchInterval, jobsStopCh, func() {
+		if err := client.DispatchQueuedWorkflowExecutions(); err != nil {
+			log.Errorf("Failed to dispatch queued workflow executions: %v", err)
+		}
+	})
+
+	go runPeriodically(v1.RetentionInterval, jobsStopCh, func() {
+		if err := client.ApplyRetentionPolicies(); err != nil {
+			log.Errorf("Failed to apply retention policies: %v", err)
+		}
+	})
+
+	go runPeriodically(v1.SweepReconcileInterval, jobsStopCh, func() {
+		if err := client.ReconcileSweeps(); err != nil {
+			log.Errorf("Failed to reconcile sweeps: %v", err)
+		}
+	})
+
+	go runPeriodically(v1.NotificationDeliveryInterval, jobsStopCh, func() {
+		if err := client.DeliverNotifications(); err != nil {
+			log.Errorf("Failed to deliver notifications: %v", err)
+		}
+	})
+
+	go runPeriodically(v1.ArtifactTriggerPollInterval, jobsStopCh, func() {
+		if err := client.PollArtifactTriggers(); err != nil {
+			log.Errorf("Failed to poll artifact triggers: %v", err)
+		}
+	})
+
+	go func() {
+		if err := client.RunWorkflowExecutionController(jobsStopCh); err != nil {
+			log.Errorf("Failed to run workflow execution controller: %v", err)
+		}
+	}()
+
+	return jobsStopCh
+}
+
+// runPeriodically calls job every interval until stopCh is closed
+func runPeriodically(interval time.Duration, stopCh <-chan struct{}, job func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			job()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// startMetricsServer serves the expvar metrics, such as the authorization cache statistics.
+// It listens on its own port so metrics aren't exposed with the API.
+func startMetricsServer() {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	log.Printf("Starting metrics server on port %v", *metricsPort)
+	if err := http.ListenAndServe(*metricsPort, mux); err != nil {
+		log.Fatalf("Failed to serve metrics listener: %v", err)
+	}
+}
+
 type registerFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
 
 func registerHandler(register registerFunc, ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) {
@@ -270,7 +385,41 @@ func customHeaderMatcher(key string) (string, bool) {
 		return lowerCaseKey, true
 	case "cookie":
 		return lowerCaseKey, true
+	case "x-onepanel-signature":
+		return lowerCaseKey, true
 	default:
 		return runtime.DefaultHeaderMatcher(key)
 	}
 }
+
+// rawBodyMarshaler reads request bodies into google.api.HttpBody fields as is, like HTTPBodyMarshaler writes them.
+// Trigger payloads need the exact bytes that were posted so their signatures can be checked.
+// Other requests are decoded by the wrapped marshaler.
+type rawBodyMarshaler struct {
+	*runtime.HTTPBodyMarshaler
+}
+
+// NewDecoder returns a decoder that reads the raw body into *httpbody.HttpBody and **httpbody.HttpBody values
+func (m *rawBodyMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v interface{}) error {
+		var body *httpbody.HttpBody
+		switch typed := v.(type) {
+		case **httpbody.HttpBody:
+			*typed = &httpbody.HttpBody{}
+			body = *typed
+		case *httpbody.HttpBody:
+			body = typed
+		default:
+			return m.HTTPBodyMarshaler.NewDecoder(r).Decode(v)
+		}
+
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		body.ContentType = "application/octet-stream"
+		body.Data = data
+
+		return nil
+	})
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	log "github.com/sirupsen/logrus"
+)
+
+// applyAuditEventFilter adds the where clauses for the non empty fields in filter
+func applyAuditEventFilter(query sq.SelectBuilder, filter *AuditEventFilter) sq.SelectBuilder {
+	if filter == nil {
+		return query
+	}
+
+	if filter.Namespace != "" {
+		query = query.Where(sq.Eq{"namespace": filter.Namespace})
+	}
+	if filter.Username != "" {
+		query = query.Where(sq.Eq{"username": filter.Username})
+	}
+	if filter.ResourceUID != "" {
+		query = query.Where(sq.Eq{"resource_uid": filter.ResourceUID})
+	}
+	if filter.Since != nil {
+		query = query.Where(sq.GtOrEq{"created_at": filter.Since.UTC()})
+	}
+	if filter.Until != nil {
+		query = query.Where(sq.Lt{"created_at": filter.Until.UTC()})
+	}
+
+	return query
+}
+
+// CreateAuditEvent records the audit event
+func (c *Client) CreateAuditEvent(event *AuditEvent) error {
+	if event.Request == "" {
+		event.Request = "{}"
+	}
+
+	err := sb.Insert("audit_events").
+		SetMap(sq.Eq{
+			"username":     event.Username,
+			"method":       event.Method,
+			"namespace":    event.Namespace,
+			"resource_uid": event.ResourceUID,
+			"request":      event.Request,
+			"code":         event.Code,
+			"latency_ms":   event.LatencyMS,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Method": event.Method,
+			"Error":  err.Error(),
+		}).Error("Unable to create audit event.")
+	}
+
+	return err
+}
+
+// ListAuditEvents returns the audit events matching filter, newest first
+func (c *Client) ListAuditEvents(filter *AuditEventFilter, paginator *pagination.PaginationRequest) (events []*AuditEvent, err error) {
+	query := sb.Select(getAuditEventColumns()...).
+		From("audit_events").
+		OrderBy("created_at DESC", "id DESC")
+	query = applyAuditEventFilter(query, filter)
+	query = *paginator.ApplyToSelect(&query)
+
+	err = c.DB.Selectx(&events, query)
+
+	return
+}
+
+// CountAuditEvents returns the number of audit events matching filter
+func (c *Client) CountAuditEvents(filter *AuditEventFilter) (count int, err error) {
+	query := sb.Select("COUNT(*)").
+		From("audit_events")
+	query = applyAuditEventFilter(query, filter)
+
+	err = query.RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
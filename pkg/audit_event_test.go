@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ListAuditEvents(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	events := []*AuditEvent{
+		{Username: "admin", Method: "/api.WorkspaceService/DeleteWorkspace", Namespace: "onepanel", ResourceUID: "ws", Code: "OK"},
+		{Username: "admin", Method: "/api.SecretService/DeleteSecret", Namespace: "other", ResourceUID: "aws", Code: "OK"},
+		{Username: "ci", Method: "/api.WorkflowService/CreateWorkflowExecution", Namespace: "onepanel", ResourceUID: "wf", Code: "PermissionDenied"},
+	}
+	for _, event := range events {
+		assert.Nil(t, c.CreateAuditEvent(event))
+	}
+
+	result, err := c.ListAuditEvents(&AuditEventFilter{Namespace: "onepanel"}, nil)
+	assert.Nil(t, err)
+	assert.Len(t, result, 2)
+
+	result, err = c.ListAuditEvents(&AuditEventFilter{Username: "admin", ResourceUID: "aws"}, nil)
+	assert.Nil(t, err)
+	assert.Len(t, result, 1)
+
+	future := time.Now().UTC().Add(time.Hour)
+	count, err := c.CountAuditEvents(&AuditEventFilter{Since: &future})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+
+	count, err = c.CountAuditEvents(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+}
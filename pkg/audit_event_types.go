@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/sql"
+)
+
+// AuditEvent is a record of a request that changed something in the system
+type AuditEvent struct {
+	ID          uint64
+	Username    string
+	Method      string
+	Namespace   string
+	ResourceUID string `db:"resource_uid"`
+	// Request is the request as JSON, with sensitive values redacted
+	Request   string
+	Code      string
+	LatencyMS int64     `db:"latency_ms"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// AuditEventFilter limits the audit events that are listed. Empty fields are not filtered on.
+type AuditEventFilter struct {
+	Namespace   string
+	Username    string
+	ResourceUID string
+	Since       *time.Time
+	Until       *time.Time
+}
+
+// getAuditEventColumns returns all of the columns for AuditEvent modified by alias, destination.
+// see formatColumnSelect
+func getAuditEventColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "username", "method", "namespace", "resource_uid", "request", "code", "latency_ms", "created_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"strings"
 )
 
 type Config = rest.Config
@@ -23,6 +24,13 @@ type Client struct {
 	argoprojV1alpha1 argoprojv1alpha1.ArgoprojV1alpha1Interface
 	*DB
 	systemConfig SystemConfig
+	// Identity is the user the client acts on behalf of when the server impersonates them, nil otherwise
+	Identity *Identity
+	// Token is the API token the client was created from, nil otherwise. It limits what the client may do.
+	Token *APIToken
+	// CredentialKey identifies the credentials the client uses, without revealing them.
+	// Authorization decisions are cached per credential key, it is empty if they should not be cached.
+	CredentialKey string
 }
 
 func (c *Client) ArgoprojV1alpha1() argoprojv1alpha1.ArgoprojV1alpha1Interface {
@@ -70,9 +78,16 @@ func GetDefaultClientWithDB(db *DB) (*Client, error) {
 	return client, nil
 }
 
-// NewClient creates a client to interact with the Onepanel system.
-// It includes access to the database, kubernetes, argo, and configuration.
-func NewClient(config *Config, db *DB, systemConfig SystemConfig) (client *Client, err error) {
+// Clientsets are the kubernetes and argo clients for a set of credentials.
+// They are safe for concurrent use, so they can be shared between requests made with the same credentials.
+type Clientsets struct {
+	Kubernetes kubernetes.Interface
+	Argo       argoprojv1alpha1.ArgoprojV1alpha1Interface
+}
+
+// NewClientsets creates the kubernetes and argo clients for the config. The config is not modified.
+func NewClientsets(config *Config) (*Clientsets, error) {
+	config = rest.CopyConfig(config)
 	if config.BearerToken != "" {
 		config.BearerTokenFile = ""
 		config.Username = ""
@@ -83,20 +98,40 @@ func NewClient(config *Config, db *DB, systemConfig SystemConfig) (client *Clien
 
 	kubeClient, err := kubernetes.NewForConfig(config)
 	if err != nil {
-		return
+		return nil, err
 	}
 
 	argoClient, err := argoprojv1alpha1.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Clientsets{
+		Kubernetes: kubeClient,
+		Argo:       argoClient,
+	}, nil
+}
+
+// NewClient creates a client to interact with the Onepanel system.
+// It includes access to the database, kubernetes, argo, and configuration.
+func NewClient(config *Config, db *DB, systemConfig SystemConfig) (client *Client, err error) {
+	clientsets, err := NewClientsets(config)
 	if err != nil {
 		return
 	}
 
+	return NewClientFromClientsets(clientsets, db, systemConfig), nil
+}
+
+// NewClientFromClientsets creates a client that uses existing clientsets.
+// Each request should get its own Client, as the Client holds per request state, but the clientsets can be shared.
+func NewClientFromClientsets(clientsets *Clientsets, db *DB, systemConfig SystemConfig) *Client {
 	return &Client{
-		Interface:        kubeClient,
-		argoprojV1alpha1: argoClient,
+		Interface:        clientsets.Kubernetes,
+		argoprojV1alpha1: clientsets.Argo,
 		DB:               db,
 		systemConfig:     systemConfig,
-	}, nil
+	}
 }
 
 // GetS3Client initializes a client to Amazon Cloud Storage.
@@ -124,6 +159,24 @@ func (c *Client) GetGCSClient(namespace string, config *ArtifactRepositoryGCSPro
 	return gcs.NewClient(namespace, config.ServiceAccountJSON)
 }
 
+// GetAPIRouter creates a new api router using the ONEPANEL_API_URL of the system configuration
+func (c *Client) GetAPIRouter() (router.API, error) {
+	sysConfig, err := c.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := sysConfig.APIURL()
+	if apiURL == nil {
+		return nil, fmt.Errorf("unable to get api url")
+	}
+
+	protocol := sysConfig.APIProtocol()
+	host := strings.TrimSuffix(strings.TrimPrefix(*apiURL, *protocol), "/")
+
+	return router.NewAPIRouter(*protocol, host)
+}
+
 // GetWebRouter creates a new web router using the system configuration
 func (c *Client) GetWebRouter() (router.Web, error) {
 	sysConfig, err := c.GetSystemConfig()
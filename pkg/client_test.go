@@ -100,7 +100,16 @@ func DefaultTestClient() *Client {
 func clearDatabase(t *testing.T) {
 	// We do not delete from goose_db_version as we need it to mark the migrations as ran.
 	query := `
+		DELETE FROM tokens;
+		DELETE FROM audit_events;
+		DELETE FROM trigger_artifact_objects;
+		DELETE FROM trigger_invocations;
+		DELETE FROM triggers;
+		DELETE FROM notification_deliveries;
+		DELETE FROM notification_subscriptions;
 		DELETE FROM workspaces;
+		DELETE FROM sweeps;
+		DELETE FROM workflow_execution_metrics;
 		DELETE FROM workflow_executions;
 		DELETE FROM cron_workflows;
 		DELETE FROM workspace_templates;
@@ -3,9 +3,14 @@ package v1
 import (
 	"fmt"
 	"github.com/onepanelio/core/pkg/util/ptr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"gopkg.in/yaml.v2"
+	"strconv"
 )
 
+// numberParameterType is the type of parameters whose values must be numbers
+const numberParameterType = "input.number"
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -50,6 +55,87 @@ func IsValidParameters(parameters []Parameter) error {
 	return nil
 }
 
+// ValidateParameterValues checks the parameters a caller provides against the parameters of the template they are for.
+// Parameters that are not in templateParameters are rejected unless their name is in extraNames.
+// Parameters that are not provided take the value of the template parameter.
+// Required parameters must have a value, values of parameters with options must be one of them and number values must parse.
+// The result is empty if all parameters are valid.
+func ValidateParameterValues(templateParameters []Parameter, parameters []Parameter, extraNames ...string) (violations []*errdetails.BadRequest_FieldViolation) {
+	templateParametersByName := MapParametersByName(templateParameters)
+	values := make(map[string]*string)
+	for _, param := range parameters {
+		values[param.Name] = param.Value
+	}
+
+	for _, name := range extraNames {
+		delete(values, name)
+	}
+
+	for _, param := range parameters {
+		if _, ok := values[param.Name]; !ok {
+			continue
+		}
+		if _, ok := templateParametersByName[param.Name]; !ok {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       parameterField(param.Name),
+				Description: fmt.Sprintf("Unknown parameter '%v'.", param.Name),
+			})
+		}
+	}
+
+	for _, templateParameter := range templateParameters {
+		value, ok := values[templateParameter.Name]
+		if !ok {
+			value = templateParameter.Value
+		}
+
+		if value == nil || *value == "" {
+			if templateParameter.Required {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{
+					Field:       parameterField(templateParameter.Name),
+					Description: fmt.Sprintf("Parameter '%v' is required.", templateParameter.Name),
+				})
+			}
+			continue
+		}
+
+		if description := validateParameterValue(templateParameter, *value); description != "" {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       parameterField(templateParameter.Name),
+				Description: description,
+			})
+		}
+	}
+
+	return
+}
+
+// validateParameterValue returns a description of why value is invalid for the parameter, or an empty string if it is valid
+func validateParameterValue(parameter Parameter, value string) string {
+	if len(parameter.Options) > 0 {
+		for _, option := range parameter.Options {
+			if option != nil && option.Value == value {
+				return ""
+			}
+		}
+
+		return fmt.Sprintf("Value '%v' is not one of the options of parameter '%v'.", value, parameter.Name)
+	}
+
+	if parameter.Type == numberParameterType {
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Sprintf("Value '%v' of parameter '%v' is not a number.", value, parameter.Name)
+		}
+	}
+
+	return ""
+}
+
+// parameterField is the field path of the named parameter in field violations
+func parameterField(name string) string {
+	return "parameters." + name
+}
+
 // Arguments are the arguments in a manifest file.
 type Arguments struct {
 	Parameters []Parameter `json:"parameters"`
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"github.com/onepanelio/core/pkg/util/ptr"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -66,3 +67,61 @@ func TestParseParametersFromManifest(t *testing.T) {
 	// Make sure string values are correctly parsed
 	assert.Equal(t, *keyedParameters["extras"].Value, "none")
 }
+
+// TestValidateParameterValues makes sure provided parameters are checked against the template parameters
+func TestValidateParameterValues(t *testing.T) {
+	manifest := `arguments:
+  parameters:
+  - name: epochs
+    type: input.number
+    value: 10
+  - name: dataset
+    type: input.text
+    required: true
+  - name: extras
+    type: textarea.textarea
+  - name: sys-node-pool
+    type: select.select
+    value: Standard_D2s_v3
+    options:
+    - name: 'CPU: 2, RAM: 8GB'
+      value: Standard_D2s_v3
+    - name: 'CPU: 4, RAM: 16GB'
+      value: Standard_D4s_v3
+`
+	templateParameters, err := ParseParametersFromManifest([]byte(manifest))
+	assert.Nil(t, err)
+
+	fields := func(parameters []Parameter) []string {
+		result := make([]string, 0)
+		for _, violation := range ValidateParameterValues(templateParameters, parameters, "workflow-execution-name") {
+			result = append(result, violation.Field)
+		}
+		return result
+	}
+
+	// Defaults are used for parameters that are not provided
+	assert.Empty(t, fields([]Parameter{
+		{Name: "dataset", Value: ptr.String("datasets/test")},
+		{Name: "workflow-execution-name", Value: ptr.String("test")},
+	}))
+
+	// Required parameters must have a value
+	assert.Equal(t, []string{"parameters.dataset"}, fields([]Parameter{}))
+	assert.Equal(t, []string{"parameters.dataset"}, fields([]Parameter{{Name: "dataset", Value: ptr.String("")}}))
+
+	// Numbers must parse, values must be among the options and unknown parameters are rejected
+	assert.Equal(t, []string{"parameters.unknown", "parameters.epochs", "parameters.sys-node-pool"}, fields([]Parameter{
+		{Name: "dataset", Value: ptr.String("datasets/test")},
+		{Name: "epochs", Value: ptr.String("ten")},
+		{Name: "sys-node-pool", Value: ptr.String("Standard_NC6")},
+		{Name: "unknown", Value: ptr.String("value")},
+	}))
+
+	// Decimal numbers and other options are valid
+	assert.Empty(t, fields([]Parameter{
+		{Name: "dataset", Value: ptr.String("datasets/test")},
+		{Name: "epochs", Value: ptr.String("2.5")},
+		{Name: "sys-node-pool", Value: ptr.String("Standard_D4s_v3")},
+	}))
+}
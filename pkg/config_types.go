@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/onepanelio/core/pkg/util/ptr"
 	log "github.com/sirupsen/logrus"
@@ -12,8 +13,16 @@ import (
 	k8yaml "sigs.k8s.io/yaml"
 )
 
+const (
+	// DefaultArtifactURLExpiry is how long presigned artifact urls are valid for if the system config does not set it
+	DefaultArtifactURLExpiry = 15 * time.Minute
+	// MaxArtifactURLExpiry is the longest S3 and GCS allow presigned urls to be valid for
+	MaxArtifactURLExpiry = 7 * 24 * time.Hour
+)
+
 // SystemConfig is configuration loaded from kubernetes config and secrets that includes information about the
 // database, server, etc.
+// A SystemConfig is shared by all requests once it is loaded, so it must not be modified.
 type SystemConfig map[string]string
 
 // NodePoolOption extends ParameterOption to support resourceRequirements
@@ -199,6 +208,163 @@ func (s SystemConfig) HMACKey() []byte {
 	return []byte(*hmac)
 }
 
+// OIDC loads and parses the oidc configuration.
+// If there is no oidc configuration, nil is returned, meaning OIDC authentication is disabled.
+func (s SystemConfig) OIDC() (config *OIDCConfig, err error) {
+	data, ok := s["oidc"]
+	if !ok || strings.TrimSpace(data) == "" {
+		return nil, nil
+	}
+
+	config = &OIDCConfig{}
+	if err = k8yaml.Unmarshal([]byte(data), config); err != nil {
+		return nil, err
+	}
+
+	if config.IssuerURL == "" {
+		return nil, fmt.Errorf("oidc config is missing issuerURL")
+	}
+	if config.ClientID == "" {
+		return nil, fmt.Errorf("oidc config is missing clientID")
+	}
+
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = "sub"
+	}
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+
+	return
+}
+
+// ExecutionQueue loads and parses the executionQueue configuration.
+// If there is no executionQueue configuration, nil is returned, meaning workflow executions are never queued.
+func (s SystemConfig) ExecutionQueue() (config *ExecutionQueueConfig, err error) {
+	data, ok := s["executionQueue"]
+	if !ok || strings.TrimSpace(data) == "" {
+		return nil, nil
+	}
+
+	config = &ExecutionQueueConfig{}
+	if err = k8yaml.Unmarshal([]byte(data), config); err != nil {
+		return nil, err
+	}
+
+	for _, labelLimit := range config.LabelLimits {
+		if labelLimit.Key == "" {
+			return nil, fmt.Errorf("executionQueue labelLimits are missing a key")
+		}
+	}
+
+	return
+}
+
+// ExecutionQueueConfig limits the number of workflow executions that run at the same time.
+// Limits apply to each namespace separately, and a limit of 0 means there is no limit.
+// Executions over a limit wait in the Queued phase until enough executions finish.
+type ExecutionQueueConfig struct {
+	// NamespaceLimit is the number of executions that can run in a namespace
+	NamespaceLimit int `json:"namespaceLimit,omitempty"`
+	// TemplateLimit is the number of executions of the same workflow template that can run in a namespace
+	TemplateLimit int `json:"templateLimit,omitempty"`
+	// LabelLimits limit the executions that have a label
+	LabelLimits []ExecutionQueueLabelLimit `json:"labelLimits,omitempty"`
+}
+
+// ExecutionQueueLabelLimit is the number of executions with a label that can run in a namespace.
+// If Value is empty, the limit applies to each value of the label separately.
+type ExecutionQueueLabelLimit struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+	Limit int    `json:"limit"`
+}
+
+// RetentionPolicies loads and parses the retentionPolicies configuration.
+// If there is no retentionPolicies configuration, nil is returned, meaning workflow executions are kept until they are archived.
+func (s SystemConfig) RetentionPolicies() (policies []RetentionPolicy, err error) {
+	data, ok := s["retentionPolicies"]
+	if !ok || strings.TrimSpace(data) == "" {
+		return nil, nil
+	}
+
+	if err = k8yaml.Unmarshal([]byte(data), &policies); err != nil {
+		return nil, err
+	}
+
+	for i, policy := range policies {
+		if policy.ArchiveAfterDays < 0 || policy.KeepLast < 0 {
+			return nil, fmt.Errorf("retentionPolicies[%v] archiveAfterDays and keepLast can't be negative", i)
+		}
+		if policy.ArchiveAfterDays == 0 && policy.KeepLast == 0 {
+			return nil, fmt.Errorf("retentionPolicies[%v] needs archiveAfterDays or keepLast", i)
+		}
+	}
+
+	return
+}
+
+// RetentionPolicy decides which finished workflow executions are archived automatically.
+// An execution is archived when it is older than ArchiveAfterDays and is not one of the KeepLast newest of its template.
+// Either limit may be 0, meaning it doesn't apply, but not both.
+type RetentionPolicy struct {
+	// Namespace the policy applies to, every namespace if empty
+	Namespace string `json:"namespace,omitempty"`
+	// Phases of the executions the policy applies to, every finished phase if empty
+	Phases []string `json:"phases,omitempty"`
+	// ArchiveAfterDays is the number of days after an execution finished that it is archived
+	ArchiveAfterDays int `json:"archiveAfterDays,omitempty"`
+	// KeepLast is the number of newest executions of each workflow template that are never archived
+	KeepLast int `json:"keepLast,omitempty"`
+	// KeepLabeled keeps executions that have any label
+	KeepLabeled bool `json:"keepLabeled,omitempty"`
+	// KeepLabels keeps executions that have any of these label keys
+	KeepLabels []string `json:"keepLabels,omitempty"`
+}
+
+// ArtifactURLExpiry parses the artifactURLExpiry configuration, a duration like "1h".
+// It is how long presigned artifact urls are valid for by default, and the longest they can be requested for.
+// If there is no artifactURLExpiry configuration, DefaultArtifactURLExpiry is returned.
+func (s SystemConfig) ArtifactURLExpiry() (time.Duration, error) {
+	data, ok := s["artifactURLExpiry"]
+	if !ok || strings.TrimSpace(data) == "" {
+		return DefaultArtifactURLExpiry, nil
+	}
+
+	expiry, err := time.ParseDuration(strings.TrimSpace(data))
+	if err != nil {
+		return 0, err
+	}
+	if expiry < time.Second || expiry > MaxArtifactURLExpiry {
+		return 0, fmt.Errorf("artifactURLExpiry must be between 1s and %v", MaxArtifactURLExpiry)
+	}
+
+	return expiry, nil
+}
+
+// NotificationsAllowPrivateNetworks returns true if the notificationsAllowPrivateNetworks configuration is "true".
+// Unless it is, notifications are not sent to loopback, link-local and private addresses, so subscriptions can't reach in-cluster services.
+func (s SystemConfig) NotificationsAllowPrivateNetworks() bool {
+	value := s.GetValue("notificationsAllowPrivateNetworks")
+
+	return value != nil && strings.TrimSpace(*value) == "true"
+}
+
+// OIDCConfig holds the settings used to validate tokens issued by an OpenID Connect provider.
+// These mirror the kube-apiserver --oidc-* flags so the same identity provider setup can be reused.
+type OIDCConfig struct {
+	// IssuerURL must match the "iss" claim of the token exactly
+	IssuerURL string `json:"issuerURL"`
+	// ClientID must be one of the values in the "aud" claim of the token
+	ClientID string `json:"clientID"`
+	// JWKSURL is optional. If empty, it is discovered from the issuer's /.well-known/openid-configuration
+	JWKSURL        string `json:"jwksURL,omitempty"`
+	UsernameClaim  string `json:"usernameClaim,omitempty"`
+	UsernamePrefix string `json:"usernamePrefix,omitempty"`
+	GroupsClaim    string `json:"groupsClaim,omitempty"`
+	GroupsPrefix   string `json:"groupsPrefix,omitempty"`
+}
+
 // ArtifactRepositoryS3Provider is meant to be used
 // by the CLI. CLI will marshal this struct into the correct
 // YAML structure for k8s configmap / secret.
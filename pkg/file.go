@@ -0,0 +1,604 @@
+package v1
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/gcs"
+	"github.com/onepanelio/core/pkg/util/s3"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// maxArtifactKeyLength is the longest key S3 and GCS allow
+const maxArtifactKeyLength = 1024
+
+// ArtifactOutOfRangeReason is the ErrorInfo reason of a range outside of an artifact, the "size" metadata is the size of the artifact
+const ArtifactOutOfRangeReason = "ARTIFACT_OUT_OF_RANGE"
+
+// artifactBucket is the artifact repository of a namespace, with a client for its provider
+type artifactBucket struct {
+	namespace string
+	name      string
+	// prefix is the start of every key the namespace may write to
+	prefix    string
+	keyFormat string
+	s3Client  *s3.Client
+	gcsClient *gcs.Client
+}
+
+// artifactKeyPrefix returns the prefix of the keys of the namespace in its artifact repository.
+// It is the part of keyFormat before its first placeholder other than the namespace, up to the last "/".
+// -> artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}} would return artifacts/namespace/
+//
+// The prefix must have the namespace as one of its path segments, otherwise the keys of other namespaces would be under it.
+func artifactKeyPrefix(keyFormat, namespace string) (string, error) {
+	prefix := strings.Replace(keyFormat, "{{workflow.namespace}}", namespace, -1)
+	if placeholderIndex := strings.Index(prefix, "{{"); placeholderIndex >= 0 {
+		prefix = prefix[:placeholderIndex]
+	}
+	prefix = prefix[:strings.LastIndex(prefix, "/")+1]
+
+	if namespace == "" || !strings.Contains("/"+prefix, "/"+namespace+"/") {
+		return "", util.NewUserError(codes.FailedPrecondition, "The keyFormat of the artifact repository must start with a path that has the namespace.")
+	}
+
+	return prefix, nil
+}
+
+// workflowArtifactKeyPrefix returns the prefix of the keys of the outputs of the workflow execution with the uid.
+// It is the part of keyFormat, with the namespace and uid filled in, before its first other placeholder, up to the last "/".
+// -> artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}} would return artifacts/namespace/uid/
+//
+// If the prefix doesn't have the uid, the keyFormat doesn't put the outputs of each execution under their own path
+// and an empty string is returned.
+func workflowArtifactKeyPrefix(keyFormat, namespace, uid string) string {
+	if uid == "" {
+		return ""
+	}
+
+	prefix := strings.Replace(keyFormat, "{{workflow.namespace}}", namespace, -1)
+	prefix = strings.Replace(prefix, "{{workflow.name}}", uid, -1)
+	if placeholderIndex := strings.Index(prefix, "{{"); placeholderIndex >= 0 {
+		prefix = prefix[:placeholderIndex]
+	}
+	prefix = prefix[:strings.LastIndex(prefix, "/")+1]
+
+	if !strings.Contains("/"+prefix, "/"+uid+"/") {
+		return ""
+	}
+
+	return prefix
+}
+
+// artifactKeyPlaceholder matches the placeholders of a keyFormat, such as {{pod.name}}
+var artifactKeyPlaceholder = regexp.MustCompile(`{{[^}]*}}`)
+
+// artifactPrefixOverlapsOutputs returns true if keys under the prefix may be keys of workflow outputs,
+// which are under keyFormat with the namespace filled in and any value for its other placeholders.
+// -> artifacts/{{workflow.namespace}}/outputs/{{workflow.name}}/{{pod.name}} overlaps artifacts/namespace/outputs/ but not artifacts/namespace/datasets/
+func artifactPrefixOverlapsOutputs(keyFormat, namespace, prefix string) bool {
+	formatSegments := strings.Split(strings.Replace(keyFormat, "{{workflow.namespace}}", namespace, -1), "/")
+	prefixSegments := strings.Split(prefix, "/")
+
+	for i, prefixSegment := range prefixSegments {
+		// Outputs are files and directories under the formatted key
+		if i == len(formatSegments) {
+			return true
+		}
+
+		formatSegment := formatSegments[i]
+		placeholderIndex := strings.Index(formatSegment, "{{")
+
+		// The last segment of the prefix may be the start of a segment of an output key
+		if i == len(prefixSegments)-1 {
+			if placeholderIndex < 0 {
+				return strings.HasPrefix(formatSegment, prefixSegment)
+			}
+			literal := formatSegment[:placeholderIndex]
+			return strings.HasPrefix(literal, prefixSegment) || strings.HasPrefix(prefixSegment, literal)
+		}
+
+		literals := artifactKeyPlaceholder.Split(formatSegment, -1)
+		for j := range literals {
+			literals[j] = regexp.QuoteMeta(literals[j])
+		}
+		if !regexp.MustCompile("^" + strings.Join(literals, "[^/]+") + "$").MatchString(prefixSegment) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateArtifactKey returns an error if the key is not a valid key under the prefix.
+// Directory keys end with a "/", the keys of files must not.
+func validateArtifactKey(prefix, key string, directory bool) error {
+	if key == "" {
+		return util.NewUserError(codes.InvalidArgument, "Key is required.")
+	}
+	if len(key) > maxArtifactKeyLength {
+		return util.NewUserError(codes.InvalidArgument, "Key is too long.")
+	}
+	if strings.HasSuffix(key, "/") != directory {
+		if directory {
+			return util.NewUserError(codes.InvalidArgument, "Directory keys must end with a '/'.")
+		}
+		return util.NewUserError(codes.InvalidArgument, "File keys must not end with a '/'.")
+	}
+
+	for _, r := range key {
+		if unicode.IsControl(r) {
+			return util.NewUserError(codes.InvalidArgument, "Key must not contain control characters.")
+		}
+	}
+
+	segments := strings.Split(strings.TrimSuffix(key, "/"), "/")
+	for _, segment := range segments {
+		if segment == "" || segment == "." || segment == ".." {
+			return util.NewUserError(codes.InvalidArgument, "Key must not contain empty, '.' or '..' path segments.")
+		}
+	}
+
+	if !strings.HasPrefix(key, prefix) || key == prefix {
+		return util.NewUserError(codes.PermissionDenied, "Key must be under '"+prefix+"'.")
+	}
+
+	return nil
+}
+
+// getArtifactBucket returns the artifact repository of the namespace, it must be an S3 or GCS repository
+func (c *Client) getArtifactBucket(namespace string) (*artifactBucket, error) {
+	config, err := c.GetNamespaceConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case config.ArtifactRepository.S3 != nil:
+		prefix, err := artifactKeyPrefix(config.ArtifactRepository.S3.KeyFormat, namespace)
+		if err != nil {
+			return nil, err
+		}
+		s3Client, err := c.GetS3Client(namespace, config.ArtifactRepository.S3)
+		if err != nil {
+			return nil, err
+		}
+
+		return &artifactBucket{
+			namespace: namespace,
+			name:      config.ArtifactRepository.S3.Bucket,
+			prefix:    prefix,
+			keyFormat: config.ArtifactRepository.S3.KeyFormat,
+			s3Client:  s3Client,
+		}, nil
+	case config.ArtifactRepository.GCS != nil:
+		prefix, err := artifactKeyPrefix(config.ArtifactRepository.GCS.KeyFormat, namespace)
+		if err != nil {
+			return nil, err
+		}
+		gcsClient, err := c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
+		if err != nil {
+			return nil, err
+		}
+
+		return &artifactBucket{
+			namespace: namespace,
+			name:      config.ArtifactRepository.GCS.Bucket,
+			prefix:    prefix,
+			keyFormat: config.ArtifactRepository.GCS.KeyFormat,
+			gcsClient: gcsClient,
+		}, nil
+	}
+
+	return nil, util.NewUserError(codes.FailedPrecondition, "Namespace has no artifact repository.")
+}
+
+// workflowPrefix returns the prefix of the keys of the outputs of the workflow execution with the uid,
+// or the prefix of the namespace if the keyFormat doesn't put them under their own path
+func (b *artifactBucket) workflowPrefix(uid string) string {
+	if prefix := workflowArtifactKeyPrefix(b.keyFormat, b.namespace, uid); prefix != "" {
+		return prefix
+	}
+
+	return b.prefix
+}
+
+// upload writes the object, size is -1 if it is unknown
+func (b *artifactBucket) upload(key string, reader io.Reader, size int64, contentType string) error {
+	if b.s3Client != nil {
+		return b.s3Client.UploadObject(b.name, key, reader, size, contentType)
+	}
+
+	return b.gcsClient.UploadObject(b.name, key, reader, contentType)
+}
+
+// delete deletes the object, it is not an error if it does not exist
+func (b *artifactBucket) delete(key string) error {
+	if b.s3Client != nil {
+		return b.s3Client.DeleteObject(b.name, key)
+	}
+
+	return b.gcsClient.DeleteObject(b.name, key)
+}
+
+// move copies the object to destination, then deletes it
+func (b *artifactBucket) move(source, destination string) error {
+	var err error
+	if b.s3Client != nil {
+		err = b.s3Client.CopyObjectWithinBucket(b.name, source, destination)
+	} else {
+		err = b.gcsClient.CopyObjectWithinBucket(b.name, source, destination)
+	}
+	if err != nil {
+		return err
+	}
+
+	return b.delete(source)
+}
+
+// presignedURL returns a url that allows the http method on the object until it expires
+func (b *artifactBucket) presignedURL(key, method string, expires time.Duration) (string, error) {
+	if b.s3Client != nil {
+		return b.s3Client.PresignedURL(b.name, key, method, expires)
+	}
+
+	return b.gcsClient.PresignedURL(b.name, key, method, expires)
+}
+
+// stat returns the size and content type of the object
+func (b *artifactBucket) stat(key string) (size int64, contentType string, err error) {
+	if b.s3Client != nil {
+		info, err := b.s3Client.StatObject(b.name, key, s3.StatObjectOptions{})
+		if s3.IsNotFound(err) {
+			return 0, "", util.NewUserError(codes.NotFound, "Artifact does not exist.")
+		}
+		return info.Size, info.ContentType, err
+	}
+
+	attrs, err := b.gcsClient.StatObject(b.name, key)
+	if gcs.IsNotFound(err) {
+		return 0, "", util.NewUserError(codes.NotFound, "Artifact does not exist.")
+	}
+	if err != nil {
+		return 0, "", err
+	}
+
+	return attrs.Size, attrs.ContentType, nil
+}
+
+// getRange reads length bytes of the object starting at the byte offset
+func (b *artifactBucket) getRange(key string, offset, length int64) (io.ReadCloser, error) {
+	if b.s3Client != nil {
+		return b.s3Client.GetObjectRange(b.name, key, offset, length)
+	}
+
+	return b.gcsClient.GetObjectRange(b.name, key, offset, length)
+}
+
+// artifactError logs the error of an artifact repository operation and returns a user error for it
+func artifactError(namespace, key, message string, err error) error {
+	if _, ok := err.(*util.UserError); ok {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"Namespace": namespace,
+		"Key":       key,
+		"Error":     err.Error(),
+	}).Error(message)
+
+	return util.NewUserError(codes.Unavailable, message)
+}
+
+// countingReader counts the bytes read from the reader it wraps
+type countingReader struct {
+	io.Reader
+	count int64
+}
+
+func (r *countingReader) Read(p []byte) (n int, err error) {
+	n, err = r.Reader.Read(p)
+	r.count += int64(n)
+
+	return
+}
+
+// UploadArtifact writes the content of reader to the key in the artifact repository of the namespace, replacing any object it has.
+// size is -1 if it is unknown. The key must be under the prefix of the namespace's keys, see artifactKeyPrefix.
+func (c *Client) UploadArtifact(namespace, key string, reader io.Reader, size int64, contentType string) (*File, error) {
+	bucket, err := c.getArtifactBucket(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateArtifactKey(bucket.prefix, key, false); err != nil {
+		return nil, err
+	}
+
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	counter := &countingReader{Reader: reader}
+	if err := bucket.upload(key, counter, size, contentType); err != nil {
+		return nil, artifactError(namespace, key, "Unable to upload file.", err)
+	}
+
+	return &File{
+		Path:         key,
+		Name:         FilePathToName(key),
+		Extension:    FilePathToExtension(key),
+		Size:         counter.count,
+		ContentType:  contentType,
+		LastModified: time.Now().UTC(),
+	}, nil
+}
+
+// CreateArtifactDirectory creates an empty directory in the artifact repository of the namespace.
+// A "/" is added to the key if it does not end with one.
+func (c *Client) CreateArtifactDirectory(namespace, key string) (*File, error) {
+	if !strings.HasSuffix(key, "/") {
+		key += "/"
+	}
+
+	bucket, err := c.getArtifactBucket(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateArtifactKey(bucket.prefix, key, true); err != nil {
+		return nil, err
+	}
+
+	if err := bucket.upload(key, strings.NewReader(""), 0, "application/x-directory"); err != nil {
+		return nil, artifactError(namespace, key, "Unable to create directory.", err)
+	}
+
+	return &File{
+		Path:         key,
+		Name:         FilePathToName(key),
+		LastModified: time.Now().UTC(),
+		Directory:    true,
+	}, nil
+}
+
+// DeleteArtifacts deletes the file with the key from the artifact repository of the namespace,
+// or everything in the directory if the key ends with a "/". It returns the number of objects it deleted.
+func (c *Client) DeleteArtifacts(namespace, key string) (int, error) {
+	bucket, err := c.getArtifactBucket(namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	directory := strings.HasSuffix(key, "/")
+	if err := validateArtifactKey(bucket.prefix, key, directory); err != nil {
+		return 0, err
+	}
+
+	keys := []string{key}
+	if directory {
+		objects, err := c.listArtifactObjects(namespace, key, true)
+		if err != nil {
+			return 0, artifactError(namespace, key, "Unable to list files.", err)
+		}
+		for _, object := range objects {
+			keys = append(keys, object.Path)
+		}
+	}
+
+	for i, objectKey := range keys {
+		if err := bucket.delete(objectKey); err != nil {
+			return i, artifactError(namespace, objectKey, "Unable to delete file.", err)
+		}
+	}
+
+	return len(keys), nil
+}
+
+// MoveArtifacts moves the file with the source key to the destination key in the artifact repository of the namespace,
+// or everything in the directory if the source key ends with a "/".
+// A file moved to a key that ends with a "/" keeps its name. It returns the number of objects it moved.
+//
+// Objects are copied then deleted one at a time, if an error occurs the objects that were moved stay at the destination.
+func (c *Client) MoveArtifacts(namespace, source, destination string) (int, error) {
+	bucket, err := c.getArtifactBucket(namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	directory := strings.HasSuffix(source, "/")
+	if err := validateArtifactKey(bucket.prefix, source, directory); err != nil {
+		return 0, err
+	}
+
+	if directory && !strings.HasSuffix(destination, "/") {
+		destination += "/"
+	}
+	if !directory && strings.HasSuffix(destination, "/") {
+		destination += FilePathToName(source)
+	}
+	if err := validateArtifactKey(bucket.prefix, destination, directory); err != nil {
+		return 0, err
+	}
+
+	if source == destination {
+		return 0, util.NewUserError(codes.InvalidArgument, "Source and destination are the same.")
+	}
+	if directory && strings.HasPrefix(destination, source) {
+		return 0, util.NewUserError(codes.InvalidArgument, "A directory can't be moved into itself.")
+	}
+
+	if !directory {
+		if err := bucket.move(source, destination); err != nil {
+			return 0, artifactError(namespace, source, "Unable to move file.", err)
+		}
+		return 1, nil
+	}
+
+	objects, err := c.listArtifactObjects(namespace, source, true)
+	if err != nil {
+		return 0, artifactError(namespace, source, "Unable to list files.", err)
+	}
+
+	for i, object := range objects {
+		if err := bucket.move(object.Path, destination+strings.TrimPrefix(object.Path, source)); err != nil {
+			return i, artifactError(namespace, object.Path, "Unable to move file.", err)
+		}
+	}
+
+	// The directory itself may only exist as the prefix of its objects
+	if err := bucket.upload(destination, strings.NewReader(""), 0, "application/x-directory"); err != nil {
+		return len(objects), artifactError(namespace, destination, "Unable to create directory.", err)
+	}
+	if err := bucket.delete(source); err != nil {
+		return len(objects), artifactError(namespace, source, "Unable to delete directory.", err)
+	}
+
+	return len(objects), nil
+}
+
+// getArtifactURLExpiry returns how long a presigned url is valid for, expiresIn if it is set.
+// It can't be longer than the artifactURLExpiry of the system config.
+func (c *Client) getArtifactURLExpiry(expiresIn time.Duration) (time.Duration, error) {
+	sysConfig, err := c.GetSystemConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	maxExpiry, err := sysConfig.ArtifactURLExpiry()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Error": err.Error(),
+		}).Error("Invalid artifactURLExpiry configuration.")
+		return 0, util.NewUserError(codes.FailedPrecondition, "Invalid artifactURLExpiry configuration.")
+	}
+
+	if expiresIn == 0 {
+		return maxExpiry, nil
+	}
+	if expiresIn < time.Second || expiresIn > maxExpiry {
+		return 0, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Expiry must be between 1s and %v.", maxExpiry))
+	}
+
+	return expiresIn, nil
+}
+
+// GetArtifactURL returns a presigned url that downloads (GET) or uploads (PUT) the file with the key
+// in the artifact repository of the namespace, without credentials, and when it expires.
+// expiresIn is how long the url is valid for, the system config's artifactURLExpiry if it is 0.
+func (c *Client) GetArtifactURL(namespace, key, method string, expiresIn time.Duration) (url string, expiresAt time.Time, err error) {
+	method = strings.ToUpper(method)
+	if method == "" {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodPut {
+		return "", expiresAt, util.NewUserError(codes.InvalidArgument, "Method must be GET or PUT.")
+	}
+
+	expires, err := c.getArtifactURLExpiry(expiresIn)
+	if err != nil {
+		return "", expiresAt, err
+	}
+
+	bucket, err := c.getArtifactBucket(namespace)
+	if err != nil {
+		return "", expiresAt, err
+	}
+	if err := validateArtifactKey(bucket.prefix, key, false); err != nil {
+		return "", expiresAt, err
+	}
+
+	expiresAt = time.Now().UTC().Add(expires)
+	url, err = bucket.presignedURL(key, method, expires)
+	if err == gcs.ErrSigningUnavailable {
+		return "", expiresAt, util.NewUserError(codes.FailedPrecondition, "The artifact repository's service account can't sign urls.")
+	}
+	if err != nil {
+		return "", expiresAt, artifactError(namespace, key, "Unable to sign url.", err)
+	}
+
+	return url, expiresAt, nil
+}
+
+// ArtifactReader reads a range of bytes of an artifact, it must be closed
+type ArtifactReader struct {
+	io.ReadCloser
+	ContentType string
+	// Size of the whole artifact
+	Size int64
+	// Offset of the first byte that is read
+	Offset int64
+	// Length is the number of bytes that are read
+	Length int64
+}
+
+// artifactRange returns the offset and length of the bytes to read from an object of the size.
+// A negative offset reads the last -offset bytes, like an HTTP "bytes=-N" range,
+// and a length of 0 or less reads until the end of the object.
+func artifactRange(size, offset, length int64) (int64, int64, error) {
+	if offset < 0 {
+		offset += size
+		if offset < 0 {
+			offset = 0
+		}
+	}
+	if offset > size || (offset == size && size > 0) {
+		return 0, 0, util.NewInfoError(codes.OutOfRange, fmt.Sprintf("Offset must be less than the size of the artifact, %v bytes.", size),
+			ArtifactOutOfRangeReason, map[string]string{"size": strconv.FormatInt(size, 10)})
+	}
+
+	if length <= 0 || offset+length > size {
+		length = size - offset
+	}
+
+	return offset, length, nil
+}
+
+// GetArtifactRange returns a reader of the bytes of the artifact with the key in the artifact repository of the namespace,
+// from the byte offset and for length bytes, see artifactRange. Unlike GetArtifact, the artifact is not read into memory.
+// The key must be under the outputs of the workflow execution with the uid.
+func (c *Client) GetArtifactRange(namespace, uid, key string, offset, length int64) (*ArtifactReader, error) {
+	bucket, err := c.getArtifactBucket(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateArtifactKey(bucket.workflowPrefix(uid), key, false); err != nil {
+		return nil, err
+	}
+
+	size, contentType, err := bucket.stat(key)
+	if err != nil {
+		return nil, artifactError(namespace, key, "Unable to get artifact.", err)
+	}
+
+	offset, length, err = artifactRange(size, offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := &ArtifactReader{
+		ReadCloser:  ioutil.NopCloser(strings.NewReader("")),
+		ContentType: contentType,
+		Size:        size,
+		Offset:      offset,
+		Length:      length,
+	}
+	if length == 0 {
+		return reader, nil
+	}
+
+	reader.ReadCloser, err = bucket.getRange(key, offset, length)
+	if err != nil {
+		return nil, artifactError(namespace, key, "Unable to get artifact.", err)
+	}
+
+	return reader, nil
+}
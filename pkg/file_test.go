@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestArtifactKeyPrefix(t *testing.T) {
+	prefix, err := artifactKeyPrefix("artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}", "onepanel")
+	assert.Nil(t, err)
+	assert.Equal(t, "artifacts/onepanel/", prefix)
+
+	prefix, err = artifactKeyPrefix("{{workflow.namespace}}/run-{{workflow.name}}", "onepanel")
+	assert.Nil(t, err)
+	assert.Equal(t, "onepanel/", prefix)
+
+	// The keys of other namespaces would be under these prefixes
+	for _, keyFormat := range []string{
+		"artifacts/{{workflow.name}}/{{pod.name}}",
+		"{{workflow.name}}",
+		"artifacts/{{workflow.namespace}}-{{workflow.name}}/{{pod.name}}",
+		"onepanel-artifacts/{{workflow.name}}",
+	} {
+		_, err = artifactKeyPrefix(keyFormat, "onepanel")
+		userErr, ok := err.(*util.UserError)
+		if assert.True(t, ok, keyFormat) {
+			assert.Equal(t, codes.FailedPrecondition, userErr.Code, keyFormat)
+		}
+	}
+}
+
+func TestWorkflowArtifactKeyPrefix(t *testing.T) {
+	assert.Equal(t, "artifacts/onepanel/wf-1/", workflowArtifactKeyPrefix("artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}", "onepanel", "wf-1"))
+
+	// The outputs of each execution are not under their own path
+	assert.Equal(t, "", workflowArtifactKeyPrefix("{{workflow.namespace}}/run-{{workflow.name}}", "onepanel", "wf-1"))
+	assert.Equal(t, "", workflowArtifactKeyPrefix("artifacts/{{workflow.namespace}}/{{pod.name}}", "onepanel", "wf-1"))
+	assert.Equal(t, "", workflowArtifactKeyPrefix("artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}", "onepanel", ""))
+}
+
+func TestArtifactPrefixOverlapsOutputs(t *testing.T) {
+	keyFormat := "artifacts/{{workflow.namespace}}/outputs/{{workflow.name}}/{{pod.name}}"
+	assert.True(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/"))
+	assert.True(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/out"))
+	assert.True(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/outputs/wf-1/"))
+	assert.True(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/outputs/wf-1/pod-1/data/"))
+	assert.False(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/datasets/"))
+	assert.False(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/outputs-old/"))
+
+	// Any path segment after the namespace may be the name of a workflow execution
+	keyFormat = "artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}"
+	assert.True(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/datasets/"))
+
+	keyFormat = "artifacts/{{workflow.namespace}}/run-{{workflow.name}}/{{pod.name}}"
+	assert.True(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/run-wf-1/"))
+	assert.False(t, artifactPrefixOverlapsOutputs(keyFormat, "onepanel", "artifacts/onepanel/datasets/"))
+}
+
+func TestValidateArtifactKey(t *testing.T) {
+	prefix := "artifacts/onepanel/"
+	tests := []struct {
+		key       string
+		directory bool
+		code      codes.Code
+	}{
+		{key: "artifacts/onepanel/data/a.csv", code: codes.OK},
+		{key: "artifacts/onepanel/data/", directory: true, code: codes.OK},
+		{key: "", code: codes.InvalidArgument},
+		{key: prefix + strings.Repeat("a", maxArtifactKeyLength), code: codes.InvalidArgument},
+		{key: "artifacts/onepanel/data/", code: codes.InvalidArgument},
+		{key: "artifacts/onepanel/data", directory: true, code: codes.InvalidArgument},
+		{key: "artifacts/onepanel/a\nb", code: codes.InvalidArgument},
+		{key: "/artifacts/onepanel/a.csv", code: codes.InvalidArgument},
+		{key: "artifacts/onepanel//a.csv", code: codes.InvalidArgument},
+		{key: "artifacts/onepanel/./a.csv", code: codes.InvalidArgument},
+		{key: "artifacts/onepanel/../other/a.csv", code: codes.InvalidArgument},
+		{key: "artifacts/other/a.csv", code: codes.PermissionDenied},
+		{key: "artifacts/onepanel/", directory: true, code: codes.PermissionDenied},
+	}
+
+	for _, test := range tests {
+		err := validateArtifactKey(prefix, test.key, test.directory)
+		if test.code == codes.OK {
+			assert.Nil(t, err, test.key)
+			continue
+		}
+
+		userErr, ok := err.(*util.UserError)
+		if assert.True(t, ok, test.key) {
+			assert.Equal(t, test.code, userErr.Code, test.key)
+		}
+	}
+}
+
+func TestSystemConfig_ArtifactURLExpiry(t *testing.T) {
+	expiry, err := SystemConfig{}.ArtifactURLExpiry()
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultArtifactURLExpiry, expiry)
+
+	expiry, err = SystemConfig{"artifactURLExpiry": "2h"}.ArtifactURLExpiry()
+	assert.Nil(t, err)
+	assert.Equal(t, 2*time.Hour, expiry)
+
+	_, err = SystemConfig{"artifactURLExpiry": "8d"}.ArtifactURLExpiry()
+	assert.NotNil(t, err)
+
+	_, err = SystemConfig{"artifactURLExpiry": "200h"}.ArtifactURLExpiry()
+	assert.NotNil(t, err)
+
+	_, err = SystemConfig{"artifactURLExpiry": "100ms"}.ArtifactURLExpiry()
+	assert.NotNil(t, err)
+}
+
+func TestArtifactRange(t *testing.T) {
+	tests := []struct {
+		size, offset, length   int64
+		wantOffset, wantLength int64
+		outOfRange             bool
+	}{
+		{size: 100, wantOffset: 0, wantLength: 100},
+		{size: 100, offset: 10, length: 20, wantOffset: 10, wantLength: 20},
+		{size: 100, offset: 90, length: 20, wantOffset: 90, wantLength: 10},
+		{size: 100, offset: 99, wantOffset: 99, wantLength: 1},
+		{size: 100, offset: -30, wantOffset: 70, wantLength: 30},
+		{size: 100, offset: -300, wantOffset: 0, wantLength: 100},
+		{size: 0, wantOffset: 0, wantLength: 0},
+		{size: 100, offset: 100, outOfRange: true},
+		{size: 0, offset: 1, outOfRange: true},
+	}
+
+	for _, test := range tests {
+		offset, length, err := artifactRange(test.size, test.offset, test.length)
+		if test.outOfRange {
+			userErr, ok := err.(*util.UserError)
+			if assert.True(t, ok) {
+				assert.Equal(t, codes.OutOfRange, userErr.Code)
+			}
+			info := util.ErrorInfo(err, ArtifactOutOfRangeReason)
+			if assert.NotNil(t, info) {
+				assert.Equal(t, strconv.FormatInt(test.size, 10), info.Metadata["size"])
+			}
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, test.wantOffset, offset)
+		assert.Equal(t, test.wantLength, length)
+	}
+}
@@ -14,6 +14,8 @@ type File struct {
 	ContentType  string
 	LastModified time.Time
 	Directory    bool
+	// ETag identifies the content of the object, it changes when the object is overwritten
+	ETag string
 }
 
 // FilePathToParentPath given a path, returns the parent path, assuming a '/' delimiter
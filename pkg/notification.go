@@ -0,0 +1,723 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/onepanelio/core/pkg/util/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// notificationLockID is the postgres advisory lock that makes one server at a time send notifications
+	notificationLockID = 51290
+	// maxNotificationAttempts is how many times a delivery is attempted before it fails
+	maxNotificationAttempts = 6
+	// maxNotificationSourceUpdateAttempts is how many times updateNotificationSource reads the phase again when another update changed it first
+	maxNotificationSourceUpdateAttempts = 5
+	// notificationRetryDelay is the delay before the first retry of a delivery, it doubles with each retry
+	notificationRetryDelay = 30 * time.Second
+	// notificationDeliveryBatchSize is the most deliveries that are sent each time DeliverNotifications runs
+	notificationDeliveryBatchSize = 100
+	// maxNotificationErrorLength limits the error that is kept for a delivery
+	maxNotificationErrorLength = 1024
+	// NotificationDeliveryInterval is how often pending notifications are sent
+	NotificationDeliveryInterval = 5 * time.Second
+)
+
+// notificationEvents are the valid event types of subscriptions
+var notificationEvents = map[string]bool{
+	NotificationEventWorkflowExecutionRunning:   true,
+	NotificationEventWorkflowExecutionSucceeded: true,
+	NotificationEventWorkflowExecutionFailed:    true,
+	NotificationEventWorkflowExecutionError:     true,
+	NotificationEventWorkspaceRunning:           true,
+	NotificationEventWorkspacePaused:            true,
+	NotificationEventWorkspaceTerminated:        true,
+	NotificationEventWorkspaceFailedToLaunch:    true,
+	NotificationEventWorkspaceFailedToPause:     true,
+	NotificationEventWorkspaceFailedToResume:    true,
+	NotificationEventWorkspaceFailedToTerminate: true,
+	NotificationEventWorkspaceFailedToUpdate:    true,
+}
+
+// notificationHTTPClient sends notifications, notificationPrivateHTTPClient is used instead if the system config allows private networks
+var (
+	notificationHTTPClient        = newNotificationHTTPClient(false)
+	notificationPrivateHTTPClient = newNotificationHTTPClient(true)
+)
+
+// privateNotificationNetworks are the private address ranges of RFC 1918 and RFC 4193
+var privateNotificationNetworks = parseCIDRs("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7")
+
+// parseCIDRs parses the cidrs, they are expected to be valid
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks
+}
+
+// isPrivateNotificationAddress returns true if the ip is a loopback, link-local, private or unspecified address
+func isPrivateNotificationAddress(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsUnspecified() {
+		return true
+	}
+
+	for _, network := range privateNotificationNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newNotificationHTTPClient returns a client to send notifications with, the timeout keeps slow webhooks from holding up other deliveries.
+// Unless allowPrivateNetworks is true, connections to private addresses are refused after the host is resolved,
+// which includes redirects, and proxies from the environment are not used as they would connect instead.
+func newNotificationHTTPClient(allowPrivateNetworks bool) *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+
+	if !allowPrivateNetworks {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateNotificationAddress(ip) {
+				return fmt.Errorf("notifications can't be sent to the private address %v", host)
+			}
+			return nil
+		}
+		transport.Proxy = nil
+	}
+
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+	}
+}
+
+// workflowExecutionNotificationEvent returns the event type of a workflow execution phase, or an empty string if there is none
+func workflowExecutionNotificationEvent(phase wfv1.NodePhase) string {
+	switch phase {
+	case wfv1.NodeRunning:
+		return NotificationEventWorkflowExecutionRunning
+	case wfv1.NodeSucceeded:
+		return NotificationEventWorkflowExecutionSucceeded
+	case wfv1.NodeFailed:
+		return NotificationEventWorkflowExecutionFailed
+	case wfv1.NodeError:
+		return NotificationEventWorkflowExecutionError
+	}
+
+	return ""
+}
+
+// workspaceNotificationEvent returns the event type of a workspace phase, or an empty string if there is none
+func workspaceNotificationEvent(phase WorkspacePhase) string {
+	switch phase {
+	case WorkspaceRunning:
+		return NotificationEventWorkspaceRunning
+	case WorkspacePaused:
+		return NotificationEventWorkspacePaused
+	case WorkspaceTerminated:
+		return NotificationEventWorkspaceTerminated
+	case WorkspaceFailedToLaunch:
+		return NotificationEventWorkspaceFailedToLaunch
+	case WorkspaceFailedToPause:
+		return NotificationEventWorkspaceFailedToPause
+	case WorkspaceFailedToResume:
+		return NotificationEventWorkspaceFailedToResume
+	case WorkspaceFailedToTerminate:
+		return NotificationEventWorkspaceFailedToTerminate
+	case WorkspaceFailedToUpdate:
+		return NotificationEventWorkspaceFailedToUpdate
+	}
+
+	return ""
+}
+
+// validate returns an error if the subscription can't be used to deliver events
+func (s *NotificationSubscription) validate() error {
+	if s.Name == "" {
+		return util.NewUserError(codes.InvalidArgument, "Subscription name is required.")
+	}
+
+	webhookURL, err := url.Parse(s.URL)
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return util.NewUserError(codes.InvalidArgument, "Subscription url must be an http or https url.")
+	}
+
+	if s.Format != NotificationFormatJSON && s.Format != NotificationFormatSlack {
+		return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Subscription format must be %v or %v.", NotificationFormatJSON, NotificationFormatSlack))
+	}
+
+	if len(s.Events) == 0 {
+		return util.NewUserError(codes.InvalidArgument, "Subscriptions need at least one event.")
+	}
+	for _, event := range s.Events {
+		if !notificationEvents[event] {
+			return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Unknown event '%v'.", event))
+		}
+	}
+
+	return nil
+}
+
+// matches returns true if the event should be delivered to the subscription
+func (s *NotificationSubscription) matches(event *NotificationEvent) bool {
+	subscribed := false
+	for _, eventType := range s.Events {
+		if eventType == event.Type {
+			subscribed = true
+			break
+		}
+	}
+	if !subscribed {
+		return false
+	}
+
+	for key, value := range s.Labels {
+		if eventValue, ok := event.Labels[key]; !ok || eventValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// notificationJSONPayload is the body of json notifications
+type notificationJSONPayload struct {
+	Delivery   string            `json:"delivery"`
+	Event      string            `json:"event"`
+	Namespace  string            `json:"namespace"`
+	Kind       string            `json:"kind"`
+	UID        string            `json:"uid"`
+	Name       string            `json:"name"`
+	Phase      string            `json:"phase"`
+	Labels     map[string]string `json:"labels"`
+	URL        string            `json:"url,omitempty"`
+	OccurredAt time.Time         `json:"occurredAt"`
+}
+
+// notificationSlackPayload is the body of slack notifications
+type notificationSlackPayload struct {
+	Text string `json:"text"`
+}
+
+// buildNotificationPayload returns the body of the delivery of the event in the format of the subscription
+func buildNotificationPayload(format, deliveryUID string, event *NotificationEvent) ([]byte, error) {
+	if format == NotificationFormatSlack {
+		kind := "Workflow execution"
+		if event.Kind == "Workspace" {
+			kind = "Workspace"
+		}
+
+		name := "*" + event.Name + "*"
+		if event.URL != "" {
+			name = fmt.Sprintf("<%v|%v>", event.URL, event.Name)
+		}
+
+		return json.Marshal(&notificationSlackPayload{
+			Text: fmt.Sprintf("%v %v in namespace %v: %v", kind, name, event.Namespace, strings.ToLower(event.Phase)),
+		})
+	}
+
+	labels := event.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+
+	return json.Marshal(&notificationJSONPayload{
+		Delivery:   deliveryUID,
+		Event:      event.Type,
+		Namespace:  event.Namespace,
+		Kind:       event.Kind,
+		UID:        event.UID,
+		Name:       event.Name,
+		Phase:      event.Phase,
+		Labels:     labels,
+		URL:        event.URL,
+		OccurredAt: event.OccurredAt,
+	})
+}
+
+// signPayload returns the hex encoded HMAC-SHA256 of the payload, prefixed with sha256=, as sent in signature headers
+func signPayload(key, payload []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignNotificationPayload returns the value of the signature header of a delivery.
+// Receivers compute it from the body and their copy of the secret to check that a delivery is genuine.
+func SignNotificationPayload(secret string, payload []byte) string {
+	return signPayload([]byte(secret), payload)
+}
+
+// nextNotificationAttempt returns when a delivery that has been attempted attempts times is tried again,
+// or nil if it should not be retried
+func nextNotificationAttempt(attempts int, now time.Time) *time.Time {
+	if attempts >= maxNotificationAttempts {
+		return nil
+	}
+
+	next := now.Add(notificationRetryDelay * time.Duration(1<<uint(attempts-1)))
+
+	return &next
+}
+
+// sendNotification posts the payload of the delivery to the webhook of its subscription.
+// The response code is 0 if no response was received.
+func sendNotification(client *http.Client, delivery *NotificationDelivery) (responseCode int, err error) {
+	payload := []byte(delivery.Payload)
+	req, err := http.NewRequest(http.MethodPost, delivery.Subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(NotificationEventHeader, delivery.Event)
+	req.Header.Set(NotificationDeliveryHeader, delivery.UID)
+	req.Header.Set(NotificationSignatureHeader, SignNotificationPayload(delivery.Subscription.Secret, payload))
+
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	// The body is not kept, it could be the response of any service the server can reach
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook responded with %v", res.Status)
+	}
+
+	return res.StatusCode, nil
+}
+
+// generateNotificationUID creates a new random uid for a subscription or delivery
+func generateNotificationUID() (string, error) {
+	return generateAPITokenUID()
+}
+
+// CreateNotificationSubscription creates the subscription, a secret is generated if it doesn't have one
+func (c *Client) CreateNotificationSubscription(subscription *NotificationSubscription) (*NotificationSubscription, error) {
+	if subscription.Format == "" {
+		subscription.Format = NotificationFormatJSON
+	}
+	if subscription.Labels == nil {
+		subscription.Labels = make(types.JSONLabels)
+	}
+	if err := subscription.validate(); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if subscription.Secret == "" {
+		if subscription.Secret, err = generateAPITokenSecret(); err != nil {
+			return nil, err
+		}
+	}
+	if subscription.UID, err = generateNotificationUID(); err != nil {
+		return nil, err
+	}
+
+	err = sb.Insert("notification_subscriptions").
+		SetMap(sq.Eq{
+			"uid":       subscription.UID,
+			"namespace": subscription.Namespace,
+			"name":      subscription.Name,
+			"url":       subscription.URL,
+			"secret":    subscription.Secret,
+			"format":    subscription.Format,
+			"events":    subscription.Events,
+			"labels":    subscription.Labels,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&subscription.ID, &subscription.CreatedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": subscription.Namespace,
+			"Name":      subscription.Name,
+			"Error":     err.Error(),
+		}).Error("Unable to create notification subscription.")
+		return nil, util.NewUserErrorWrap(err, "Subscription")
+	}
+
+	return subscription, nil
+}
+
+// GetNotificationSubscription returns the subscription, or a NotFound error
+func (c *Client) GetNotificationSubscription(namespace, uid string) (*NotificationSubscription, error) {
+	subscription := &NotificationSubscription{}
+	query := sb.Select(getNotificationSubscriptionColumns()...).
+		From("notification_subscriptions").
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		})
+
+	if err := c.DB.Getx(subscription, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Subscription not found.")
+		}
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+// ListNotificationSubscriptions returns the subscriptions of the namespace, newest first
+func (c *Client) ListNotificationSubscriptions(namespace string, paginator *pagination.PaginationRequest) (subscriptions []*NotificationSubscription, err error) {
+	query := sb.Select(getNotificationSubscriptionColumns()...).
+		From("notification_subscriptions").
+		Where(sq.Eq{"namespace": namespace}).
+		OrderBy("created_at DESC", "id DESC")
+	query = *paginator.ApplyToSelect(&query)
+
+	err = c.DB.Selectx(&subscriptions, query)
+
+	return
+}
+
+// CountNotificationSubscriptions returns the number of subscriptions in the namespace
+func (c *Client) CountNotificationSubscriptions(namespace string) (count int, err error) {
+	err = sb.Select("COUNT(*)").
+		From("notification_subscriptions").
+		Where(sq.Eq{"namespace": namespace}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// DeleteNotificationSubscription deletes the subscription along with its delivery log
+func (c *Client) DeleteNotificationSubscription(namespace, uid string) error {
+	result, err := sb.Delete("notification_subscriptions").
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Subscription not found.")
+	}
+
+	return nil
+}
+
+// ListNotificationDeliveries returns the delivery log of the subscription, newest first
+func (c *Client) ListNotificationDeliveries(subscription *NotificationSubscription, paginator *pagination.PaginationRequest) (deliveries []*NotificationDelivery, err error) {
+	query := sb.Select(getNotificationDeliveryColumns()...).
+		From("notification_deliveries").
+		Where(sq.Eq{"subscription_id": subscription.ID}).
+		OrderBy("created_at DESC", "id DESC")
+	query = *paginator.ApplyToSelect(&query)
+
+	err = c.DB.Selectx(&deliveries, query)
+
+	return
+}
+
+// CountNotificationDeliveries returns the number of deliveries in the log of the subscription
+func (c *Client) CountNotificationDeliveries(subscription *NotificationSubscription) (count int, err error) {
+	err = sb.Select("COUNT(*)").
+		From("notification_deliveries").
+		Where(sq.Eq{"subscription_id": subscription.ID}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// getNotificationSource returns the workflow execution or workspace in table that an event may be about
+func (c *Client) getNotificationSource(table string, where sq.Eq) (*notificationSource, error) {
+	source := &notificationSource{}
+	query := sb.Select("uid", "name", "phase", "labels").
+		From(table).
+		Where(where)
+
+	if err := c.DB.Getx(source, query); err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+// updateNotificationSource runs the update of the workflow execution or workspace in table and returns it as it was before the update.
+// The update only applies if the phase is still the one that was read, so two concurrent updates can't both see the same phase
+// and notify subscribers of the same change. If another update changed the phase first, the phase is read again.
+// updated is false if the resource doesn't exist or the update doesn't apply to it.
+func (c *Client) updateNotificationSource(table string, where sq.Eq, update sq.UpdateBuilder) (before *notificationSource, updated bool, err error) {
+	for attempt := 0; attempt < maxNotificationSourceUpdateAttempts; attempt++ {
+		if before == nil {
+			before, err = c.getNotificationSource(table, where)
+			if err == sql.ErrNoRows {
+				return nil, false, nil
+			}
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		result, err := update.Where(sq.Eq{"phase": before.Phase}).RunWith(c.DB).Exec()
+		if err != nil {
+			return nil, false, err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, false, err
+		}
+		if rowsAffected > 0 {
+			return before, true, nil
+		}
+
+		current, err := c.getNotificationSource(table, where)
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		// The phase didn't change, the update doesn't apply to the resource
+		if current.Phase == before.Phase {
+			return before, false, nil
+		}
+		before = current
+	}
+
+	return nil, false, util.NewUserError(codes.Aborted, "Status changed too often while it was updated, try again.")
+}
+
+// emitNotificationEvent queues a delivery of the event for each subscription of its namespace that matches it.
+// Errors are logged rather than returned so notifications never fail the change they are about.
+func (c *Client) emitNotificationEvent(event *NotificationEvent) {
+	logger := log.WithFields(log.Fields{
+		"Namespace": event.Namespace,
+		"Event":     event.Type,
+		"UID":       event.UID,
+	})
+
+	var subscriptions []*NotificationSubscription
+	query := sb.Select(getNotificationSubscriptionColumns()...).
+		From("notification_subscriptions").
+		Where(sq.Eq{"namespace": event.Namespace}).
+		Where("? = ANY(events)", event.Type)
+	if err := c.DB.Selectx(&subscriptions, query); err != nil {
+		logger.WithField("Error", err.Error()).Error("Unable to get notification subscriptions.")
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.matches(event) {
+			continue
+		}
+
+		uid, err := generateNotificationUID()
+		if err != nil {
+			logger.WithField("Error", err.Error()).Error("Unable to create notification delivery.")
+			return
+		}
+
+		payload, err := buildNotificationPayload(subscription.Format, uid, event)
+		if err != nil {
+			logger.WithField("Error", err.Error()).Error("Unable to create notification payload.")
+			continue
+		}
+
+		_, err = sb.Insert("notification_deliveries").
+			SetMap(sq.Eq{
+				"uid":             uid,
+				"subscription_id": subscription.ID,
+				"event":           event.Type,
+				"resource_uid":    event.UID,
+				"payload":         string(payload),
+				"status":          NotificationDeliveryPending,
+				"next_attempt_at": event.OccurredAt,
+			}).
+			RunWith(c.DB).
+			Exec()
+		if err != nil {
+			logger.WithFields(log.Fields{
+				"Subscription": subscription.UID,
+				"Error":        err.Error(),
+			}).Error("Unable to create notification delivery.")
+		}
+	}
+}
+
+// notifyPhaseChange emits the event of a workflow execution or workspace that changed from the before phase to phase
+func (c *Client) notifyPhaseChange(namespace, kind string, before *notificationSource, phase, eventType string) {
+	if before == nil || eventType == "" || before.Phase == phase {
+		return
+	}
+
+	event := &NotificationEvent{
+		Type:       eventType,
+		Namespace:  namespace,
+		Kind:       kind,
+		UID:        before.UID,
+		Name:       before.Name,
+		Phase:      phase,
+		Labels:     before.Labels,
+		OccurredAt: time.Now().UTC(),
+	}
+
+	if webRouter, err := c.GetWebRouter(); err == nil {
+		if kind == "Workspace" {
+			event.URL = webRouter.Workspace(namespace, before.UID)
+		} else {
+			event.URL = webRouter.WorkflowExecution(namespace, before.UID)
+		}
+	}
+
+	c.emitNotificationEvent(event)
+}
+
+// notifyWorkflowExecutionPhase emits the event of a workflow execution that changed from the before phase to phase
+func (c *Client) notifyWorkflowExecutionPhase(namespace string, before *notificationSource, phase wfv1.NodePhase) {
+	c.notifyPhaseChange(namespace, "WorkflowExecution", before, string(phase), workflowExecutionNotificationEvent(phase))
+}
+
+// notifyWorkspacePhase emits the event of a workspace that changed from the before phase to phase
+func (c *Client) notifyWorkspacePhase(namespace string, before *notificationSource, phase WorkspacePhase) {
+	c.notifyPhaseChange(namespace, "Workspace", before, string(phase), workspaceNotificationEvent(phase))
+}
+
+// recordNotificationAttempt updates the delivery with the result of an attempt to send it
+func (c *Client) recordNotificationAttempt(delivery *NotificationDelivery, responseCode int, sendErr error, now time.Time) error {
+	delivery.Attempts++
+	delivery.ResponseCode = nil
+	if responseCode != 0 {
+		delivery.ResponseCode = &responseCode
+	}
+
+	delivery.Error = ""
+	delivery.NextAttemptAt = nil
+	if sendErr == nil {
+		delivery.Status = NotificationDeliveryDelivered
+		delivery.DeliveredAt = &now
+	} else {
+		delivery.Error = sendErr.Error()
+		if len(delivery.Error) > maxNotificationErrorLength {
+			delivery.Error = delivery.Error[:maxNotificationErrorLength]
+		}
+		delivery.NextAttemptAt = nextNotificationAttempt(delivery.Attempts, now)
+		if delivery.NextAttemptAt == nil {
+			delivery.Status = NotificationDeliveryFailed
+		}
+	}
+
+	_, err := sb.Update("notification_deliveries").
+		SetMap(sq.Eq{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"response_code":   delivery.ResponseCode,
+			"error":           delivery.Error,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"delivered_at":    delivery.DeliveredAt,
+		}).
+		Where(sq.Eq{"id": delivery.ID}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// DeliverNotifications sends the pending notifications that are due, retrying failed deliveries with a growing delay.
+// Only one server sends notifications at a time, other servers return without doing anything.
+// Each attempt is recorded as soon as it is made, the transaction only holds the lock.
+func (c *Client) DeliverNotifications() error {
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	locked := false
+	if err := tx.QueryRow("SELECT pg_try_advisory_xact_lock($1)", notificationLockID).Scan(&locked); err != nil {
+		return err
+	}
+	if !locked {
+		return nil
+	}
+
+	var deliveries []*NotificationDelivery
+	query := sb.Select(getNotificationDeliveryColumns("d")...).
+		Columns(getNotificationSubscriptionColumns("s", "subscription")...).
+		From("notification_deliveries d").
+		Join("notification_subscriptions s ON s.id = d.subscription_id").
+		Where(sq.Eq{"d.status": NotificationDeliveryPending}).
+		Where(sq.LtOrEq{"d.next_attempt_at": time.Now().UTC()}).
+		OrderBy("d.next_attempt_at", "d.id").
+		Limit(notificationDeliveryBatchSize)
+	if err := c.DB.Selectx(&deliveries, query); err != nil {
+		return err
+	}
+
+	client := notificationHTTPClient
+	if sysConfig, err := c.GetSystemConfig(); err == nil && sysConfig.NotificationsAllowPrivateNetworks() {
+		client = notificationPrivateHTTPClient
+	}
+
+	for _, delivery := range deliveries {
+		responseCode, sendErr := sendNotification(client, delivery)
+		if sendErr != nil {
+			log.WithFields(log.Fields{
+				"Delivery":     delivery.UID,
+				"Subscription": delivery.Subscription.UID,
+				"Attempts":     delivery.Attempts + 1,
+				"Error":        sendErr.Error(),
+			}).Warn("Unable to send notification.")
+		}
+
+		if err := c.recordNotificationAttempt(delivery, responseCode, sendErr, time.Now().UTC()); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
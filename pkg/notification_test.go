@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationEvents(t *testing.T) {
+	assert.Equal(t, NotificationEventWorkflowExecutionSucceeded, workflowExecutionNotificationEvent(wfv1.NodeSucceeded))
+	assert.Equal(t, NotificationEventWorkflowExecutionFailed, workflowExecutionNotificationEvent(wfv1.NodeFailed))
+	assert.Equal(t, "", workflowExecutionNotificationEvent(wfv1.NodePending))
+	assert.Equal(t, NotificationEventWorkspaceFailedToLaunch, workspaceNotificationEvent(WorkspaceFailedToLaunch))
+	assert.Equal(t, "", workspaceNotificationEvent(WorkspaceLaunching))
+}
+
+func TestNotificationSubscription_Validate(t *testing.T) {
+	valid := func() *NotificationSubscription {
+		return &NotificationSubscription{
+			Name:   "alerts",
+			URL:    "https://hooks.example.com/onepanel",
+			Format: NotificationFormatSlack,
+			Events: []string{NotificationEventWorkflowExecutionFailed},
+		}
+	}
+
+	assert.Nil(t, valid().validate())
+
+	subscription := valid()
+	subscription.URL = "ftp://hooks.example.com"
+	assert.NotNil(t, subscription.validate())
+
+	subscription = valid()
+	subscription.Format = "xml"
+	assert.NotNil(t, subscription.validate())
+
+	subscription = valid()
+	subscription.Events = []string{"workflow_execution.deleted"}
+	assert.NotNil(t, subscription.validate())
+
+	subscription = valid()
+	subscription.Events = nil
+	assert.NotNil(t, subscription.validate())
+}
+
+func TestNotificationSubscription_Matches(t *testing.T) {
+	subscription := &NotificationSubscription{
+		Events: []string{NotificationEventWorkflowExecutionFailed, NotificationEventWorkflowExecutionSucceeded},
+		Labels: map[string]string{"team": "vision"},
+	}
+
+	event := &NotificationEvent{
+		Type:   NotificationEventWorkflowExecutionFailed,
+		Labels: map[string]string{"team": "vision", "model": "yolo"},
+	}
+	assert.True(t, subscription.matches(event))
+
+	event.Labels = map[string]string{"team": "nlp"}
+	assert.False(t, subscription.matches(event))
+
+	event.Labels = nil
+	assert.False(t, subscription.matches(event))
+
+	subscription.Labels = nil
+	assert.True(t, subscription.matches(event))
+
+	event.Type = NotificationEventWorkflowExecutionRunning
+	assert.False(t, subscription.matches(event))
+}
+
+func TestBuildNotificationPayload(t *testing.T) {
+	event := &NotificationEvent{
+		Type:       NotificationEventWorkspaceFailedToLaunch,
+		Namespace:  "onepanel",
+		Kind:       "Workspace",
+		UID:        "jupyter",
+		Name:       "jupyter",
+		Phase:      string(WorkspaceFailedToLaunch),
+		URL:        "https://app.example.com/onepanel/workspaces/jupyter",
+		OccurredAt: time.Date(2020, 10, 26, 0, 0, 0, 0, time.UTC),
+	}
+
+	payload, err := buildNotificationPayload(NotificationFormatJSON, "d1", event)
+	assert.Nil(t, err)
+	decoded := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(payload, &decoded))
+	assert.Equal(t, "d1", decoded["delivery"])
+	assert.Equal(t, NotificationEventWorkspaceFailedToLaunch, decoded["event"])
+	assert.Equal(t, map[string]interface{}{}, decoded["labels"])
+	assert.Equal(t, "2020-10-26T00:00:00Z", decoded["occurredAt"])
+
+	payload, err = buildNotificationPayload(NotificationFormatSlack, "d1", event)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"text":"Workspace <https://app.example.com/onepanel/workspaces/jupyter|jupyter> in namespace onepanel: failed to launch"}`, string(payload))
+}
+
+func TestSignNotificationPayload(t *testing.T) {
+	// echo -n '{"a":1}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=aa9e2e3575f5d7098b6caccd790888c36d5fdb63342a73bada2d6a51747a8494", SignNotificationPayload("secret", []byte(`{"a":1}`)))
+	assert.NotEqual(t, SignNotificationPayload("secret", []byte("body")), SignNotificationPayload("other", []byte("body")))
+}
+
+func TestNextNotificationAttempt(t *testing.T) {
+	now := time.Date(2020, 10, 26, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, now.Add(30*time.Second), *nextNotificationAttempt(1, now))
+	assert.Equal(t, now.Add(60*time.Second), *nextNotificationAttempt(2, now))
+	assert.Equal(t, now.Add(8*time.Minute), *nextNotificationAttempt(5, now))
+	assert.Nil(t, nextNotificationAttempt(maxNotificationAttempts, now))
+}
+
+func TestSendNotification(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = ioutil.ReadAll(r.Body)
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("unavailable"))
+		}
+	}))
+	defer server.Close()
+
+	delivery := &NotificationDelivery{
+		UID:     "d1",
+		Event:   NotificationEventWorkflowExecutionSucceeded,
+		Payload: `{"event":"workflow_execution.succeeded"}`,
+		Subscription: &NotificationSubscription{
+			URL:    server.URL + "/hook",
+			Secret: "secret",
+		},
+	}
+
+	code, err := sendNotification(server.Client(), delivery)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, delivery.Payload, string(body))
+	assert.Equal(t, "d1", received.Header.Get(NotificationDeliveryHeader))
+	assert.Equal(t, NotificationEventWorkflowExecutionSucceeded, received.Header.Get(NotificationEventHeader))
+	assert.Equal(t, SignNotificationPayload("secret", body), received.Header.Get(NotificationSignatureHeader))
+
+	delivery.Subscription.URL = server.URL + "/broken"
+	code, err = sendNotification(server.Client(), delivery)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.NotContains(t, err.Error(), "unavailable")
+}
+
+func TestIsPrivateNotificationAddress(t *testing.T) {
+	for _, address := range []string{"127.0.0.1", "::1", "10.0.0.1", "172.16.0.1", "192.168.1.1", "169.254.169.254", "fe80::1", "fd00::1", "0.0.0.0"} {
+		assert.True(t, isPrivateNotificationAddress(net.ParseIP(address)), address)
+	}
+	for _, address := range []string{"8.8.8.8", "2001:4860:4860::8888"} {
+		assert.False(t, isPrivateNotificationAddress(net.ParseIP(address)), address)
+	}
+}
+
+func TestNewNotificationHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	delivery := &NotificationDelivery{
+		Subscription: &NotificationSubscription{URL: server.URL},
+	}
+
+	// The test server listens on a loopback address
+	_, err := sendNotification(newNotificationHTTPClient(false), delivery)
+	assert.NotNil(t, err)
+
+	code, err := sendNotification(newNotificationHTTPClient(true), delivery)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, code)
+}
@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/onepanelio/core/pkg/util/sql"
+	"github.com/onepanelio/core/pkg/util/types"
+)
+
+// Types of notification events
+const (
+	NotificationEventWorkflowExecutionRunning   = "workflow_execution.running"
+	NotificationEventWorkflowExecutionSucceeded = "workflow_execution.succeeded"
+	NotificationEventWorkflowExecutionFailed    = "workflow_execution.failed"
+	NotificationEventWorkflowExecutionError     = "workflow_execution.error"
+	NotificationEventWorkspaceRunning           = "workspace.running"
+	NotificationEventWorkspacePaused            = "workspace.paused"
+	NotificationEventWorkspaceTerminated        = "workspace.terminated"
+	NotificationEventWorkspaceFailedToLaunch    = "workspace.failed_to_launch"
+	NotificationEventWorkspaceFailedToPause     = "workspace.failed_to_pause"
+	NotificationEventWorkspaceFailedToResume    = "workspace.failed_to_resume"
+	NotificationEventWorkspaceFailedToTerminate = "workspace.failed_to_terminate"
+	NotificationEventWorkspaceFailedToUpdate    = "workspace.failed_to_update"
+)
+
+// Formats of notification payloads
+const (
+	// NotificationFormatJSON sends the notification event as JSON
+	NotificationFormatJSON = "json"
+	// NotificationFormatSlack sends a message that Slack incoming webhooks, and compatible services, can post
+	NotificationFormatSlack = "slack"
+)
+
+// Statuses of notification deliveries
+const (
+	NotificationDeliveryPending   = "Pending"
+	NotificationDeliveryDelivered = "Delivered"
+	NotificationDeliveryFailed    = "Failed"
+)
+
+// Headers sent with each notification delivery
+const (
+	// NotificationSignatureHeader is the hex encoded HMAC-SHA256 of the body, keyed with the subscription secret, prefixed with sha256=
+	NotificationSignatureHeader = "X-Onepanel-Signature"
+	NotificationEventHeader     = "X-Onepanel-Event"
+	NotificationDeliveryHeader  = "X-Onepanel-Delivery"
+)
+
+// NotificationSubscription delivers the events of a namespace to a webhook
+type NotificationSubscription struct {
+	ID        uint64
+	UID       string
+	Namespace string
+	Name      string
+	URL       string `db:"url"`
+	// Secret is the key of the signature of each delivery
+	Secret string
+	Format string
+	Events pq.StringArray
+	// Labels limits the events to resources that have all of them, all events are delivered if it is empty
+	Labels     types.JSONLabels
+	CreatedAt  time.Time  `db:"created_at"`
+	ModifiedAt *time.Time `db:"modified_at"`
+}
+
+// NotificationEvent is a change in phase of a workflow execution or workspace
+type NotificationEvent struct {
+	Type      string
+	Namespace string
+	// Kind is WorkflowExecution or Workspace
+	Kind   string
+	UID    string
+	Name   string
+	Phase  string
+	Labels map[string]string
+	// URL is where the resource can be viewed in the web client, it is empty if it is not known
+	URL        string
+	OccurredAt time.Time
+}
+
+// NotificationDelivery is an attempt to deliver an event to a subscription, it is kept as the delivery log
+type NotificationDelivery struct {
+	ID             uint64
+	UID            string
+	SubscriptionID uint64 `db:"subscription_id"`
+	Event          string
+	ResourceUID    string `db:"resource_uid"`
+	Payload        string
+	Status         string
+	Attempts       int
+	ResponseCode   *int       `db:"response_code"`
+	Error          string     `db:"error"`
+	NextAttemptAt  *time.Time `db:"next_attempt_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+	// Subscription is only loaded when the delivery is being sent
+	Subscription *NotificationSubscription `db:"subscription"`
+}
+
+// notificationSource is the state of the workflow execution or workspace that an event is about
+type notificationSource struct {
+	UID    string
+	Name   string
+	Phase  string
+	Labels types.JSONLabels
+}
+
+// getNotificationSubscriptionColumns returns all of the columns for NotificationSubscription modified by alias, destination.
+// see formatColumnSelect
+func getNotificationSubscriptionColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "uid", "namespace", "name", "url", "secret", "format", "events", "labels", "created_at", "modified_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// getNotificationDeliveryColumns returns all of the columns for NotificationDelivery modified by alias, destination.
+// see formatColumnSelect
+func getNotificationDeliveryColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "uid", "subscription_id", "event", "resource_uid", "payload", "status", "attempts", "response_code", "error", "next_attempt_at", "created_at", "delivered_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
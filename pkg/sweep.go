@@ -0,0 +1,638 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	uid2 "github.com/onepanelio/core/pkg/util/uid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	k8srand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	// sweepLockID is the first key of the postgres advisory locks that make changes to a sweep one at a time,
+	// the second key is the id of the sweep
+	sweepLockID = 51289
+	// maxSweepRuns is the largest number of runs a sweep can have
+	maxSweepRuns = 1000
+	// maxSweepParallelism is the largest number of runs of a sweep that can be active at the same time
+	maxSweepParallelism = 100
+	// SweepReconcileInterval is how often running sweeps launch runs and update their best run
+	SweepReconcileInterval = 10 * time.Second
+)
+
+// formatSweepValue formats a number of a range, rounded so steps like 0.1 don't show floating point errors
+func formatSweepValue(value float64, integer bool) string {
+	if integer {
+		return strconv.FormatInt(int64(math.Round(value)), 10)
+	}
+
+	return strconv.FormatFloat(math.Round(value*1e10)/1e10, 'f', -1, 64)
+}
+
+// validate returns an error if the parameter is not a valid search space for the algorithm
+func (p *SweepParameter) validate(algorithm string) error {
+	if p.Name == "" {
+		return fmt.Errorf("sweep parameters need a name")
+	}
+
+	isRange := p.Min != nil || p.Max != nil
+	if isRange == (len(p.Values) > 0) {
+		return fmt.Errorf("sweep parameter '%v' needs either values or a min and max", p.Name)
+	}
+	if !isRange {
+		return nil
+	}
+
+	if p.Min == nil || p.Max == nil || *p.Max < *p.Min {
+		return fmt.Errorf("sweep parameter '%v' needs a min that is not more than its max", p.Name)
+	}
+
+	switch algorithm {
+	case SweepAlgorithmGrid:
+		if p.Step == nil || *p.Step <= 0 {
+			return fmt.Errorf("sweep parameter '%v' needs a positive step for a grid search", p.Name)
+		}
+	case SweepAlgorithmList:
+		return fmt.Errorf("sweep parameter '%v' needs values for a list search", p.Name)
+	}
+
+	return nil
+}
+
+// gridValues returns the values of the parameter in a grid search, at most limit of them
+func (p *SweepParameter) gridValues(limit int) ([]string, error) {
+	if len(p.Values) > 0 {
+		return p.Values, nil
+	}
+
+	values := make([]string, 0)
+	// A small tolerance so the max is included despite floating point errors
+	for i := 0; *p.Min+float64(i)*(*p.Step) <= *p.Max+*p.Step*1e-9; i++ {
+		if len(values) == limit {
+			return nil, fmt.Errorf("sweep parameter '%v' has more than %v values", p.Name, limit)
+		}
+		values = append(values, formatSweepValue(*p.Min+float64(i)*(*p.Step), p.Integer))
+	}
+
+	return values, nil
+}
+
+// randomValue returns a random value of the parameter
+func (p *SweepParameter) randomValue(random *rand.Rand) string {
+	if len(p.Values) > 0 {
+		return p.Values[random.Intn(len(p.Values))]
+	}
+
+	return formatSweepValue(*p.Min+random.Float64()*(*p.Max-*p.Min), p.Integer)
+}
+
+// validateSweepSpec returns an error if the spec can't be run
+func validateSweepSpec(spec *SweepSpec) error {
+	if spec.Algorithm != SweepAlgorithmGrid && spec.Algorithm != SweepAlgorithmRandom && spec.Algorithm != SweepAlgorithmList {
+		return fmt.Errorf("sweep algorithm must be %v, %v or %v", SweepAlgorithmGrid, SweepAlgorithmRandom, SweepAlgorithmList)
+	}
+	if len(spec.Parameters) == 0 {
+		return fmt.Errorf("sweeps need at least one parameter")
+	}
+	if spec.MaxRuns < 0 || spec.MaxRuns > maxSweepRuns {
+		return fmt.Errorf("sweep maxRuns must be between 0 and %v", maxSweepRuns)
+	}
+	if spec.Algorithm == SweepAlgorithmRandom && spec.MaxRuns == 0 {
+		return fmt.Errorf("random sweeps need maxRuns")
+	}
+	if spec.Parallelism < 1 || spec.Parallelism > maxSweepParallelism {
+		return fmt.Errorf("sweep parallelism must be between 1 and %v", maxSweepParallelism)
+	}
+	if spec.Objective != nil {
+		if spec.Objective.Metric == "" {
+			return fmt.Errorf("sweep objectives need a metric")
+		}
+		if spec.Objective.Goal != SweepGoalMinimize && spec.Objective.Goal != SweepGoalMaximize {
+			return fmt.Errorf("sweep objective goal must be %v or %v", SweepGoalMinimize, SweepGoalMaximize)
+		}
+	}
+
+	names := make(map[string]bool)
+	for i := range spec.Parameters {
+		parameter := &spec.Parameters[i]
+		if names[parameter.Name] {
+			return fmt.Errorf("sweep parameter '%v' is repeated", parameter.Name)
+		}
+		names[parameter.Name] = true
+
+		if err := parameter.validate(spec.Algorithm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateSweepRuns returns the parameters of each run of the sweep
+func generateSweepRuns(spec *SweepSpec) (runs [][]Parameter, err error) {
+	if err := validateSweepSpec(spec); err != nil {
+		return nil, err
+	}
+
+	limit := maxSweepRuns
+	if spec.MaxRuns > 0 {
+		limit = spec.MaxRuns
+	}
+
+	switch spec.Algorithm {
+	case SweepAlgorithmGrid:
+		runs = [][]Parameter{{}}
+		for i := range spec.Parameters {
+			parameter := &spec.Parameters[i]
+			values, err := parameter.gridValues(maxSweepRuns)
+			if err != nil {
+				return nil, err
+			}
+			if len(runs)*len(values) > maxSweepRuns {
+				return nil, fmt.Errorf("grid sweeps can have at most %v runs", maxSweepRuns)
+			}
+
+			combined := make([][]Parameter, 0, len(runs)*len(values))
+			for _, run := range runs {
+				for _, value := range values {
+					combinedRun := append(append([]Parameter{}, run...), Parameter{Name: parameter.Name, Value: ptr.String(value)})
+					combined = append(combined, combinedRun)
+				}
+			}
+			runs = combined
+		}
+	case SweepAlgorithmRandom:
+		random := rand.New(rand.NewSource(spec.Seed))
+		for i := 0; i < spec.MaxRuns; i++ {
+			run := make([]Parameter, 0, len(spec.Parameters))
+			for j := range spec.Parameters {
+				run = append(run, Parameter{Name: spec.Parameters[j].Name, Value: ptr.String(spec.Parameters[j].randomValue(random))})
+			}
+			runs = append(runs, run)
+		}
+	case SweepAlgorithmList:
+		count := 1
+		for _, parameter := range spec.Parameters {
+			if len(parameter.Values) == 1 || len(parameter.Values) == count {
+				continue
+			}
+			if count != 1 {
+				return nil, fmt.Errorf("list sweep parameters need the same number of values, or a single value")
+			}
+			count = len(parameter.Values)
+		}
+
+		for i := 0; i < count; i++ {
+			run := make([]Parameter, 0, len(spec.Parameters))
+			for _, parameter := range spec.Parameters {
+				value := parameter.Values[0]
+				if len(parameter.Values) > 1 {
+					value = parameter.Values[i]
+				}
+				run = append(run, Parameter{Name: parameter.Name, Value: ptr.String(value)})
+			}
+			runs = append(runs, run)
+		}
+	}
+
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+
+	return runs, nil
+}
+
+// bestSweepValue returns the best of the values for the goal, or nil if there are none
+func bestSweepValue(values []*sweepObjectiveValue, goal string) *sweepObjectiveValue {
+	var best *sweepObjectiveValue
+	for _, value := range values {
+		if best == nil ||
+			(goal == SweepGoalMinimize && value.Value < best.Value) ||
+			(goal == SweepGoalMaximize && value.Value > best.Value) {
+			best = value
+		}
+	}
+
+	return best
+}
+
+// sweepLabels returns the labels that select the workflow executions of the sweep
+func sweepLabels(sweep *Sweep) string {
+	labels, _ := json.Marshal(map[string]string{SweepLabelKey: sweep.UID})
+
+	return string(labels)
+}
+
+func sweepSelectBuilder(namespace string) sq.SelectBuilder {
+	return sb.Select(getSweepColumns("s")...).
+		Columns(`wt.uid "workflow_template.uid"`, `wt.name "workflow_template.name"`, `wtv.version "workflow_template.version"`, `wtv.id "workflow_template.workflow_template_version_id"`).
+		From("sweeps s").
+		Join("workflow_template_versions wtv ON wtv.id = s.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{"s.namespace": namespace})
+}
+
+// loadSweep parses the spec and runs of the sweep loaded from the database
+func loadSweep(sweep *Sweep) error {
+	if err := json.Unmarshal(sweep.SpecBytes, &sweep.Spec); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(sweep.RunsBytes, &sweep.Runs)
+}
+
+// getSweep returns the sweep without the counts of its workflow executions, or a NotFound error
+func (c *Client) getSweep(namespace, uid string) (*Sweep, error) {
+	sweep := &Sweep{}
+	if err := c.DB.Getx(sweep, sweepSelectBuilder(namespace).Where(sq.Eq{"s.uid": uid})); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Sweep not found.")
+		}
+		return nil, err
+	}
+
+	return sweep, loadSweep(sweep)
+}
+
+// countSweepWorkflowExecutions sets the counts of the workflow executions of the sweep
+func (c *Client) countSweepWorkflowExecutions(sweep *Sweep) error {
+	counts := &sweepExecutionCounts{}
+	query := sb.Select(
+		"COUNT(*) FILTER (WHERE finished_at IS NULL) active",
+		"COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND phase = 'Succeeded') succeeded",
+		"COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND phase <> 'Succeeded') failed").
+		From("workflow_executions").
+		Where(sq.Eq{"namespace": sweep.Namespace}).
+		Where("labels @> ?::jsonb", sweepLabels(sweep))
+
+	if err := c.DB.Getx(counts, query); err != nil {
+		return err
+	}
+
+	sweep.Active = counts.Active
+	sweep.Succeeded = counts.Succeeded
+	sweep.Failed = counts.Failed
+
+	return nil
+}
+
+// listActiveSweepWorkflowExecutions returns the uids of the workflow executions of the sweep that have not finished
+func (c *Client) listActiveSweepWorkflowExecutions(sweep *Sweep) (uids []string, err error) {
+	query := sb.Select("uid").
+		From("workflow_executions").
+		Where(sq.Eq{
+			"namespace":   sweep.Namespace,
+			"finished_at": nil,
+		}).
+		Where("labels @> ?::jsonb", sweepLabels(sweep))
+
+	err = c.DB.Selectx(&uids, query)
+
+	return
+}
+
+// getSweepObjectiveValues returns the last value of the objective metric of each succeeded run of the sweep
+func (c *Client) getSweepObjectiveValues(sweep *Sweep) (values []*sweepObjectiveValue, err error) {
+	query := sb.Select("DISTINCT ON (we.uid) we.uid", "m.value").
+		From("workflow_execution_metrics m").
+		Join("workflow_executions we ON we.id = m.workflow_execution_id").
+		Where(sq.Eq{
+			"we.namespace": sweep.Namespace,
+			"we.phase":     "Succeeded",
+			"m.name":       sweep.Spec.Objective.Metric,
+		}).
+		Where("we.labels @> ?::jsonb", sweepLabels(sweep)).
+		OrderBy("we.uid", "m.step DESC", "m.id DESC")
+
+	err = c.DB.Selectx(&values, query)
+
+	return
+}
+
+// withSweepLock runs f while holding the lock of the sweep
+func (c *Client) withSweepLock(sweep *Sweep, f func() error) error {
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1, $2)", sweepLockID, sweep.ID); err != nil {
+		return err
+	}
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// launchSweepRun creates the workflow execution of the next run of the sweep
+func (c *Client) launchSweepRun(sweep *Sweep, workflowTemplate *WorkflowTemplate) error {
+	workflow := &WorkflowExecution{
+		Parameters: sweep.Runs[sweep.Launched],
+		Labels: map[string]string{
+			SweepLabelKey: sweep.UID,
+		},
+	}
+
+	_, err := c.CreateWorkflowExecution(sweep.Namespace, workflow, workflowTemplate)
+
+	return err
+}
+
+// reconcileSweep launches runs of the sweep while there are fewer active runs than its parallelism,
+// updates the best run and finishes the sweep once all runs have finished.
+// The sweep is reloaded under its lock, so it may be called for the same sweep at the same time.
+func (c *Client) reconcileSweep(namespace, uid string) (*Sweep, error) {
+	sweep, err := c.getSweep(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.withSweepLock(sweep, func() error {
+		if sweep, err = c.getSweep(namespace, uid); err != nil {
+			return err
+		}
+		if err := c.countSweepWorkflowExecutions(sweep); err != nil {
+			return err
+		}
+
+		changes := sq.Eq{}
+		if sweep.Spec.Objective != nil {
+			values, err := c.getSweepObjectiveValues(sweep)
+			if err != nil {
+				return err
+			}
+
+			if best := bestSweepValue(values, sweep.Spec.Objective.Goal); best != nil {
+				sweep.BestWorkflowExecutionUID = &best.UID
+				sweep.BestValue = &best.Value
+				changes["best_workflow_execution_uid"] = best.UID
+				changes["best_value"] = best.Value
+			}
+		}
+
+		if sweep.Phase == SweepRunning {
+			launched, message := sweep.Launched, sweep.Message
+
+			// The launched runs are counted instead of read from the sweep, so a run whose launch was not recorded
+			// because of an error or a crash is not launched again
+			sweep.Launched = sweep.Active + sweep.Succeeded + sweep.Failed
+			if sweep.Launched > len(sweep.Runs) {
+				sweep.Launched = len(sweep.Runs)
+			}
+
+			// A run that can't be launched is retried by the next reconcile, the runs that are running keep being tracked
+			sweep.Message = ""
+			if err := c.launchSweepRuns(sweep); err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": namespace,
+					"UID":       uid,
+					"Error":     err.Error(),
+				}).Error("Unable to launch sweep run.")
+				sweep.Message = fmt.Sprintf("Unable to launch run %v, it will be retried: %v", sweep.Launched+1, err.Error())
+			} else if sweep.Launched == len(sweep.Runs) && sweep.Active == 0 {
+				sweep.Phase = SweepSucceeded
+				sweep.FinishedAt = ptr.Time(time.Now().UTC())
+			}
+
+			if sweep.Launched != launched || sweep.Message != message || sweep.Phase != SweepRunning {
+				changes["launched"] = sweep.Launched
+				changes["phase"] = sweep.Phase
+				changes["message"] = sweep.Message
+				changes["finished_at"] = sweep.FinishedAt
+			}
+		}
+
+		if len(changes) == 0 {
+			return nil
+		}
+
+		changes["modified_at"] = time.Now().UTC()
+		_, err := sb.Update("sweeps").
+			SetMap(changes).
+			Where(sq.Eq{"id": sweep.ID}).
+			RunWith(c.DB).
+			Exec()
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sweep, nil
+}
+
+// launchSweepRuns launches runs until the parallelism of the sweep is reached or every run has been launched
+func (c *Client) launchSweepRuns(sweep *Sweep) error {
+	if sweep.Launched == len(sweep.Runs) || sweep.Active >= sweep.Spec.Parallelism {
+		return nil
+	}
+
+	workflowTemplate, err := c.GetWorkflowTemplate(sweep.Namespace, sweep.WorkflowTemplate.UID, sweep.WorkflowTemplate.Version)
+	if err != nil {
+		return err
+	}
+
+	for sweep.Launched < len(sweep.Runs) && sweep.Active < sweep.Spec.Parallelism {
+		if err := c.launchSweepRun(sweep, workflowTemplate); err != nil {
+			return err
+		}
+
+		sweep.Launched++
+		sweep.Active++
+	}
+
+	return nil
+}
+
+// CreateSweep creates the sweep and launches its first runs.
+// The parameters of every run are checked against the workflow template before anything is launched.
+func (c *Client) CreateSweep(namespace string, sweep *Sweep) (*Sweep, error) {
+	if sweep.Spec.Algorithm == SweepAlgorithmRandom && sweep.Spec.Seed == 0 {
+		sweep.Spec.Seed = time.Now().UnixNano()
+	}
+
+	runs, err := generateSweepRuns(&sweep.Spec)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	workflowTemplate, err := c.GetWorkflowTemplate(namespace, sweep.WorkflowTemplate.UID, sweep.WorkflowTemplate.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, run := range runs {
+		if err := c.validateWorkflowExecutionParameters(&WorkflowExecution{Parameters: run}, workflowTemplate); err != nil {
+			return nil, err
+		}
+	}
+
+	uid, err := uid2.GenerateUID(sweep.Name, 24)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Sweep names can have at most 24 characters.")
+	}
+	sweep.UID = uid + "-" + k8srand.String(5)
+
+	spec, err := json.Marshal(sweep.Spec)
+	if err != nil {
+		return nil, err
+	}
+	runsJSON, err := json.Marshal(runs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sb.Insert("sweeps").
+		SetMap(sq.Eq{
+			"uid":                          sweep.UID,
+			"name":                         sweep.Name,
+			"namespace":                    namespace,
+			"workflow_template_version_id": workflowTemplate.WorkflowTemplateVersionID,
+			"spec":                         string(spec),
+			"runs":                         string(runsJSON),
+			"phase":                        SweepRunning,
+		}).
+		Suffix("RETURNING id").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&sweep.ID)
+	if err != nil {
+		return nil, util.NewUserErrorWrap(err, "Sweep")
+	}
+
+	return c.reconcileSweep(namespace, sweep.UID)
+}
+
+// GetSweep returns the sweep with the counts of its workflow executions
+func (c *Client) GetSweep(namespace, uid string) (*Sweep, error) {
+	sweep, err := c.getSweep(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return sweep, c.countSweepWorkflowExecutions(sweep)
+}
+
+// ListSweeps returns the sweeps of the namespace with the counts of their workflow executions, newest first
+func (c *Client) ListSweeps(namespace string, paginator *pagination.PaginationRequest) (sweeps []*Sweep, err error) {
+	query := sweepSelectBuilder(namespace).
+		OrderBy("s.created_at DESC", "s.id DESC")
+	query = *paginator.ApplyToSelect(&query)
+
+	if err = c.DB.Selectx(&sweeps, query); err != nil {
+		return nil, err
+	}
+
+	for _, sweep := range sweeps {
+		if err := loadSweep(sweep); err != nil {
+			return nil, err
+		}
+		if err := c.countSweepWorkflowExecutions(sweep); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// CountSweeps returns the number of sweeps in the namespace
+func (c *Client) CountSweeps(namespace string) (count int, err error) {
+	err = sb.Select("COUNT(*)").
+		From("sweeps").
+		Where(sq.Eq{"namespace": namespace}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// StopSweep stops launching runs of the sweep and terminates the runs that have not finished
+func (c *Client) StopSweep(namespace, uid string) (*Sweep, error) {
+	sweep, err := c.getSweep(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.withSweepLock(sweep, func() error {
+		if sweep, err = c.getSweep(namespace, uid); err != nil {
+			return err
+		}
+		if sweep.Phase != SweepRunning {
+			return util.NewUserError(codes.FailedPrecondition, "Sweep is not running.")
+		}
+
+		sweep.Phase = SweepStopped
+		sweep.FinishedAt = ptr.Time(time.Now().UTC())
+		_, err := sb.Update("sweeps").
+			SetMap(sq.Eq{
+				"phase":       sweep.Phase,
+				"finished_at": sweep.FinishedAt,
+				"modified_at": time.Now().UTC(),
+			}).
+			Where(sq.Eq{"id": sweep.ID}).
+			RunWith(c.DB).
+			Exec()
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uids, err := c.listActiveSweepWorkflowExecutions(sweep)
+	if err != nil {
+		return nil, err
+	}
+	for _, workflowUID := range uids {
+		if err := c.TerminateWorkflowExecution(namespace, workflowUID); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       workflowUID,
+				"Sweep":     uid,
+				"Error":     err.Error(),
+			}).Error("Unable to terminate sweep run.")
+		}
+	}
+
+	return sweep, c.countSweepWorkflowExecutions(sweep)
+}
+
+// ReconcileSweeps launches runs of the running sweeps of every namespace and updates their best runs
+func (c *Client) ReconcileSweeps() error {
+	var sweeps []*Sweep
+	query := sb.Select("uid", "namespace").
+		From("sweeps").
+		Where(sq.Eq{"phase": SweepRunning})
+	if err := c.DB.Selectx(&sweeps, query); err != nil {
+		return err
+	}
+
+	for _, sweep := range sweeps {
+		if _, err := c.reconcileSweep(sweep.Namespace, sweep.UID); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": sweep.Namespace,
+				"UID":       sweep.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to reconcile sweep.")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sweepRunValues(runs [][]Parameter) [][]string {
+	result := make([][]string, 0)
+	for _, run := range runs {
+		values := make([]string, 0)
+		for _, parameter := range run {
+			values = append(values, parameter.Name+"="+*parameter.Value)
+		}
+		result = append(result, values)
+	}
+	return result
+}
+
+func TestGenerateSweepRuns_Grid(t *testing.T) {
+	min, max, step := 0.1, 0.3, 0.1
+	spec := &SweepSpec{
+		Algorithm: SweepAlgorithmGrid,
+		Parameters: []SweepParameter{
+			{Name: "lr", Min: &min, Max: &max, Step: &step},
+			{Name: "optimizer", Values: []string{"adam", "sgd"}},
+		},
+		Parallelism: 2,
+	}
+
+	runs, err := generateSweepRuns(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{
+		{"lr=0.1", "optimizer=adam"},
+		{"lr=0.1", "optimizer=sgd"},
+		{"lr=0.2", "optimizer=adam"},
+		{"lr=0.2", "optimizer=sgd"},
+		{"lr=0.3", "optimizer=adam"},
+		{"lr=0.3", "optimizer=sgd"},
+	}, sweepRunValues(runs))
+
+	spec.MaxRuns = 3
+	runs, err = generateSweepRuns(spec)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 3)
+}
+
+func TestGenerateSweepRuns_GridInteger(t *testing.T) {
+	min, max, step := 16.0, 64.0, 16.0
+	runs, err := generateSweepRuns(&SweepSpec{
+		Algorithm:   SweepAlgorithmGrid,
+		Parameters:  []SweepParameter{{Name: "batch-size", Min: &min, Max: &max, Step: &step, Integer: true}},
+		Parallelism: 1,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{{"batch-size=16"}, {"batch-size=32"}, {"batch-size=48"}, {"batch-size=64"}}, sweepRunValues(runs))
+}
+
+func TestGenerateSweepRuns_GridTooLarge(t *testing.T) {
+	min, max, step := 0.0, 100.0, 1.0
+	_, err := generateSweepRuns(&SweepSpec{
+		Algorithm: SweepAlgorithmGrid,
+		Parameters: []SweepParameter{
+			{Name: "a", Min: &min, Max: &max, Step: &step},
+			{Name: "b", Min: &min, Max: &max, Step: &step},
+		},
+		Parallelism: 1,
+	})
+	assert.NotNil(t, err)
+}
+
+func TestGenerateSweepRuns_Random(t *testing.T) {
+	min, max := 1.0, 10.0
+	spec := &SweepSpec{
+		Algorithm: SweepAlgorithmRandom,
+		Parameters: []SweepParameter{
+			{Name: "epochs", Min: &min, Max: &max, Integer: true},
+			{Name: "optimizer", Values: []string{"adam", "sgd"}},
+		},
+		MaxRuns:     20,
+		Parallelism: 5,
+		Seed:        42,
+	}
+
+	runs, err := generateSweepRuns(spec)
+	assert.Nil(t, err)
+	assert.Len(t, runs, 20)
+	for _, run := range runs {
+		assert.Len(t, run, 2)
+		assert.Contains(t, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}, *run[0].Value)
+		assert.Contains(t, []string{"adam", "sgd"}, *run[1].Value)
+	}
+
+	// The same seed generates the same runs
+	repeated, err := generateSweepRuns(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, sweepRunValues(runs), sweepRunValues(repeated))
+
+	spec.MaxRuns = 0
+	_, err = generateSweepRuns(spec)
+	assert.NotNil(t, err)
+}
+
+func TestGenerateSweepRuns_List(t *testing.T) {
+	spec := &SweepSpec{
+		Algorithm: SweepAlgorithmList,
+		Parameters: []SweepParameter{
+			{Name: "lr", Values: []string{"0.1", "0.01", "0.001"}},
+			{Name: "optimizer", Values: []string{"adam"}},
+		},
+		Parallelism: 1,
+	}
+
+	runs, err := generateSweepRuns(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]string{
+		{"lr=0.1", "optimizer=adam"},
+		{"lr=0.01", "optimizer=adam"},
+		{"lr=0.001", "optimizer=adam"},
+	}, sweepRunValues(runs))
+
+	spec.Parameters[1].Values = []string{"adam", "sgd"}
+	_, err = generateSweepRuns(spec)
+	assert.NotNil(t, err)
+}
+
+func TestGenerateSweepRuns_Invalid(t *testing.T) {
+	min, max, step := 1.0, 0.0, 1.0
+	tests := []struct {
+		name string
+		spec SweepSpec
+	}{
+		{"algorithm", SweepSpec{Algorithm: "bayesian", Parameters: []SweepParameter{{Name: "a", Values: []string{"1"}}}, Parallelism: 1}},
+		{"no parameters", SweepSpec{Algorithm: SweepAlgorithmGrid, Parallelism: 1}},
+		{"parallelism", SweepSpec{Algorithm: SweepAlgorithmGrid, Parameters: []SweepParameter{{Name: "a", Values: []string{"1"}}}}},
+		{"repeated", SweepSpec{Algorithm: SweepAlgorithmGrid, Parameters: []SweepParameter{{Name: "a", Values: []string{"1"}}, {Name: "a", Values: []string{"2"}}}, Parallelism: 1}},
+		{"min more than max", SweepSpec{Algorithm: SweepAlgorithmRandom, Parameters: []SweepParameter{{Name: "a", Min: &min, Max: &max}}, MaxRuns: 1, Parallelism: 1}},
+		{"grid without step", SweepSpec{Algorithm: SweepAlgorithmGrid, Parameters: []SweepParameter{{Name: "a", Min: &max, Max: &min}}, Parallelism: 1}},
+		{"values and range", SweepSpec{Algorithm: SweepAlgorithmGrid, Parameters: []SweepParameter{{Name: "a", Values: []string{"1"}, Min: &max, Max: &min, Step: &step}}, Parallelism: 1}},
+		{"objective goal", SweepSpec{Algorithm: SweepAlgorithmGrid, Parameters: []SweepParameter{{Name: "a", Values: []string{"1"}}}, Parallelism: 1, Objective: &SweepObjective{Metric: "loss", Goal: "lowest"}}},
+	}
+
+	for _, test := range tests {
+		_, err := generateSweepRuns(&test.spec)
+		assert.NotNil(t, err, test.name)
+	}
+}
+
+func TestBestSweepValue(t *testing.T) {
+	values := []*sweepObjectiveValue{
+		{UID: "a", Value: 0.5},
+		{UID: "b", Value: 0.2},
+		{UID: "c", Value: 0.9},
+	}
+
+	assert.Equal(t, "b", bestSweepValue(values, SweepGoalMinimize).UID)
+	assert.Equal(t, "c", bestSweepValue(values, SweepGoalMaximize).UID)
+	assert.Nil(t, bestSweepValue(nil, SweepGoalMinimize))
+}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/sql"
+)
+
+// Phases of sweeps
+const (
+	SweepRunning   = "Running"
+	SweepSucceeded = "Succeeded"
+	SweepFailed    = "Failed"
+	SweepStopped   = "Stopped"
+)
+
+// Algorithms that generate the runs of sweeps
+const (
+	// SweepAlgorithmGrid runs every combination of the parameter values
+	SweepAlgorithmGrid = "grid"
+	// SweepAlgorithmRandom runs MaxRuns random combinations of the parameter values
+	SweepAlgorithmRandom = "random"
+	// SweepAlgorithmList runs the parameter values at the same position together
+	SweepAlgorithmList = "list"
+)
+
+// Goals of sweep objectives
+const (
+	SweepGoalMinimize = "minimize"
+	SweepGoalMaximize = "maximize"
+)
+
+// SweepLabelKey labels the workflow executions of a sweep, its value is the uid of the sweep
+const SweepLabelKey = "sweep"
+
+// SweepParameter is the search space of a parameter of the workflow template.
+// It has either Values, or a range from Min to Max.
+type SweepParameter struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values,omitempty"`
+	Min    *float64 `json:"min,omitempty"`
+	Max    *float64 `json:"max,omitempty"`
+	// Step is the distance between the values of a range in a grid search
+	Step *float64 `json:"step,omitempty"`
+	// Integer makes the values of a range whole numbers
+	Integer bool `json:"integer,omitempty"`
+}
+
+// SweepObjective is the metric that decides the best run of a sweep.
+// The value of a run is the last value of the metric logged with LogMetrics.
+type SweepObjective struct {
+	Metric string `json:"metric"`
+	Goal   string `json:"goal"`
+}
+
+// SweepSpec describes the workflow executions that a sweep runs
+type SweepSpec struct {
+	Algorithm  string           `json:"algorithm"`
+	Parameters []SweepParameter `json:"parameters"`
+	// MaxRuns is the number of runs of a random search, and limits the runs of other searches if it is set
+	MaxRuns int `json:"maxRuns,omitempty"`
+	// Parallelism is the number of runs that can be active at the same time
+	Parallelism int             `json:"parallelism"`
+	Objective   *SweepObjective `json:"objective,omitempty"`
+	// Seed makes a random search repeatable, a seed is picked if it is 0
+	Seed int64 `json:"seed,omitempty"`
+}
+
+// Sweep runs a workflow template with each combination of parameter values from search spaces
+type Sweep struct {
+	ID                       uint64
+	UID                      string
+	Name                     string
+	Namespace                string
+	Phase                    string
+	Message                  string
+	WorkflowTemplate         *WorkflowTemplate `db:"workflow_template"`
+	Spec                     SweepSpec         `db:"-"`
+	SpecBytes                []byte            `db:"spec"`
+	Runs                     [][]Parameter     `db:"-"`
+	RunsBytes                []byte            `db:"runs"`
+	Launched                 int
+	BestWorkflowExecutionUID *string    `db:"best_workflow_execution_uid"`
+	BestValue                *float64   `db:"best_value"`
+	CreatedAt                time.Time  `db:"created_at"`
+	ModifiedAt               *time.Time `db:"modified_at"`
+	FinishedAt               *time.Time `db:"finished_at"`
+	// Counts of the workflow executions of the sweep, they are not stored
+	Active    int `db:"-"`
+	Succeeded int `db:"-"`
+	Failed    int `db:"-"`
+}
+
+// sweepExecutionCounts are the number of workflow executions of a sweep in each state
+type sweepExecutionCounts struct {
+	Active    int
+	Succeeded int
+	Failed    int
+}
+
+// sweepObjectiveValue is the objective value of a run of a sweep
+type sweepObjectiveValue struct {
+	UID   string
+	Value float64
+}
+
+// getSweepColumns returns all of the columns for Sweep modified by alias, destination.
+// see formatColumnSelect
+func getSweepColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "uid", "name", "namespace", "phase", "message", "spec", "runs", "launched", "best_workflow_execution_uid", "best_value", "created_at", "modified_at", "finished_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
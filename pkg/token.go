@@ -0,0 +1,172 @@
+package v1
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// apiTokenLastUsedResolution limits how often the last used timestamp of a token is written to the database
+const apiTokenLastUsedResolution = time.Minute
+
+func apiTokenSelectBuilder() sq.SelectBuilder {
+	return sb.Select(getAPITokenColumns()...).
+		From("tokens")
+}
+
+// CreateAPIToken stores a new token for the token's identity and scope.
+// The returned secret is the only time the plain token is available, it is not stored.
+func (c *Client) CreateAPIToken(token *APIToken) (secret string, err error) {
+	if token.ExpiresAt != nil && !token.ExpiresAt.After(time.Now().UTC()) {
+		return "", util.NewUserError(codes.InvalidArgument, "Token expiry must be in the future.")
+	}
+
+	secret, err = generateAPITokenSecret()
+	if err != nil {
+		return "", err
+	}
+
+	token.UID, err = generateAPITokenUID()
+	if err != nil {
+		return "", err
+	}
+
+	token.TokenHash = HashAPIToken(secret)
+	if token.Groups == nil {
+		token.Groups = make([]string, 0)
+	}
+
+	err = sb.Insert("tokens").
+		SetMap(sq.Eq{
+			"uid":        token.UID,
+			"name":       token.Name,
+			"token_hash": token.TokenHash,
+			"username":   token.Username,
+			"groups":     token.Groups,
+			"read_only":  token.ReadOnly,
+			"namespace":  token.Namespace,
+			"expires_at": token.ExpiresAt,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Name":     token.Name,
+			"Username": token.Username,
+			"Error":    err.Error(),
+		}).Error("Unable to create token.")
+		return "", util.NewUserErrorWrap(err, "Token")
+	}
+
+	return secret, nil
+}
+
+// ListAPITokens returns the tokens that belong to username, newest first. Revoked tokens are included.
+func (c *Client) ListAPITokens(username string, paginator *pagination.PaginationRequest) (tokens []*APIToken, err error) {
+	query := apiTokenSelectBuilder().
+		Where(sq.Eq{
+			"username": username,
+		}).
+		OrderBy("created_at DESC")
+	query = *paginator.ApplyToSelect(&query)
+
+	err = c.DB.Selectx(&tokens, query)
+
+	return
+}
+
+// CountAPITokens returns the number of tokens that belong to username
+func (c *Client) CountAPITokens(username string) (count int, err error) {
+	err = sb.Select("COUNT(*)").
+		From("tokens").
+		Where(sq.Eq{
+			"username": username,
+		}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// RevokeAPIToken revokes the token with uid that belongs to username.
+// If namespace is not empty, only a token limited to that namespace is revoked.
+// Revoking a token that is already revoked is not an error.
+func (c *Client) RevokeAPIToken(username, uid, namespace string) error {
+	where := sq.Eq{
+		"uid":      uid,
+		"username": username,
+	}
+	if namespace != "" {
+		where["namespace"] = namespace
+	}
+
+	result, err := sb.Update("tokens").
+		Set("revoked_at", sq.Expr("COALESCE(revoked_at, NOW() at time zone 'utc')")).
+		Where(where).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Token not found.")
+	}
+
+	return nil
+}
+
+// GetAPITokenBySecret returns the token for the secret, or nil if there is no such token.
+// Expired and revoked tokens are returned, callers should check IsActive.
+func (c *Client) GetAPITokenBySecret(secret string) (*APIToken, error) {
+	token := &APIToken{}
+	query := apiTokenSelectBuilder().
+		Where(sq.Eq{
+			"token_hash": HashAPIToken(secret),
+		})
+
+	if err := c.DB.Getx(token, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// TouchAPIToken records that the token was used.
+// The timestamp is only written if it is older than apiTokenLastUsedResolution, so busy tokens don't cause a write per request.
+func (c *Client) TouchAPIToken(token *APIToken) error {
+	now := time.Now().UTC()
+	if token.LastUsedAt != nil && now.Sub(*token.LastUsedAt) < apiTokenLastUsedResolution {
+		return nil
+	}
+
+	_, err := sb.Update("tokens").
+		Set("last_used_at", now).
+		Where(sq.Eq{
+			"id": token.ID,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	token.LastUsedAt = &now
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CreateAPIToken(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	token := &APIToken{
+		Name:     "ci",
+		Username: "system:serviceaccount:onepanel:admin",
+		ReadOnly: true,
+	}
+	secret, err := c.CreateAPIToken(token)
+	assert.Nil(t, err)
+	assert.True(t, IsAPIToken(secret))
+	assert.NotEmpty(t, token.UID)
+
+	found, err := c.GetAPITokenBySecret(secret)
+	assert.Nil(t, err)
+	assert.NotNil(t, found)
+	assert.Equal(t, token.UID, found.UID)
+	assert.True(t, found.ReadOnly)
+
+	missing, err := c.GetAPITokenBySecret(APITokenPrefix + "unknown")
+	assert.Nil(t, err)
+	assert.Nil(t, missing)
+
+	past := time.Now().UTC().Add(-time.Minute)
+	_, err = c.CreateAPIToken(&APIToken{
+		Name:      "expired",
+		Username:  "admin",
+		ExpiresAt: &past,
+	})
+	assert.NotNil(t, err)
+}
+
+func TestClient_RevokeAPIToken(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	token := &APIToken{
+		Name:     "ci",
+		Username: "admin",
+	}
+	secret, err := c.CreateAPIToken(token)
+	assert.Nil(t, err)
+
+	// Tokens can only be revoked by their owner
+	err = c.RevokeAPIToken("someone-else", token.UID, "")
+	assert.NotNil(t, err)
+
+	// A token for all namespaces is outside of any one namespace
+	err = c.RevokeAPIToken("admin", token.UID, "onepanel")
+	assert.NotNil(t, err)
+
+	err = c.RevokeAPIToken("admin", token.UID, "")
+	assert.Nil(t, err)
+
+	found, err := c.GetAPITokenBySecret(secret)
+	assert.Nil(t, err)
+	assert.False(t, found.IsActive())
+}
+
+func TestClient_ListAPITokens(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	for _, username := range []string{"admin", "admin", "other"} {
+		_, err := c.CreateAPIToken(&APIToken{
+			Name:     "ci",
+			Username: username,
+		})
+		assert.Nil(t, err)
+	}
+
+	tokens, err := c.ListAPITokens("admin", nil)
+	assert.Nil(t, err)
+	assert.Len(t, tokens, 2)
+
+	count, err := c.CountAPITokens("admin")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+}
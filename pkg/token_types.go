@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/onepanelio/core/pkg/util/sql"
+)
+
+// APITokenPrefix is prepended to every API token secret so they can be told apart from kubernetes tokens
+const APITokenPrefix = "op_"
+
+// APIToken is a revocable token that acts as a kubernetes identity, optionally limited in scope.
+// Only the hash of the secret is stored; the secret itself is returned once, when the token is created.
+type APIToken struct {
+	ID         uint64
+	UID        string
+	Name       string
+	TokenHash  string `db:"token_hash"`
+	Username   string
+	Groups     pq.StringArray
+	ReadOnly   bool       `db:"read_only"`
+	Namespace  string     `db:"namespace"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+// readOnlyVerbs are the kubernetes verbs a read only token is allowed to perform
+var readOnlyVerbs = map[string]bool{
+	"get":   true,
+	"list":  true,
+	"watch": true,
+}
+
+// getAPITokenColumns returns all of the columns for APIToken modified by alias, destination.
+// see formatColumnSelect
+func getAPITokenColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "uid", "name", "token_hash", "username", "groups", "read_only", "namespace", "expires_at", "last_used_at", "revoked_at", "created_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// IsExpired returns true if the token has an expiry date that has passed
+func (t *APIToken) IsExpired() bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(time.Now().UTC())
+}
+
+// IsRevoked returns true if the token has been revoked
+func (t *APIToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsActive returns true if the token can still be used to authenticate
+func (t *APIToken) IsActive() bool {
+	return !t.IsExpired() && !t.IsRevoked()
+}
+
+// Allows returns true if the token's scope permits the verb in the namespace.
+// This only narrows what the token's identity can do, kubernetes RBAC still applies.
+func (t *APIToken) Allows(namespace, verb string) bool {
+	if t.ReadOnly && !readOnlyVerbs[verb] {
+		return false
+	}
+
+	if t.Namespace != "" && t.Namespace != namespace {
+		return false
+	}
+
+	return true
+}
+
+// Identity returns the kubernetes identity the token acts as
+func (t *APIToken) Identity() *Identity {
+	return &Identity{
+		Username: t.Username,
+		Groups:   t.Groups,
+	}
+}
+
+// IsAPIToken returns true if the secret looks like an API token, as opposed to a kubernetes or oidc token
+func IsAPIToken(secret string) bool {
+	return strings.HasPrefix(secret, APITokenPrefix)
+}
+
+// HashAPIToken returns the value stored in the database for the token secret
+func HashAPIToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// generateAPITokenSecret creates a new random token secret
+func generateAPITokenSecret() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return APITokenPrefix + strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(data)), nil
+}
+
+// generateAPITokenUID creates a new random uid for a token
+func generateAPITokenUID() (string, error) {
+	data := make([]byte, 10)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(data), nil
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAPIToken_Allows tests that read only and namespace scopes are enforced
+func TestAPIToken_Allows(t *testing.T) {
+	token := &APIToken{}
+	assert.True(t, token.Allows("onepanel", "delete"))
+	assert.True(t, token.Allows("", "list"))
+
+	token.ReadOnly = true
+	assert.True(t, token.Allows("onepanel", "get"))
+	assert.True(t, token.Allows("onepanel", "watch"))
+	assert.False(t, token.Allows("onepanel", "create"))
+
+	token.Namespace = "onepanel"
+	assert.True(t, token.Allows("onepanel", "list"))
+	assert.False(t, token.Allows("other", "list"))
+	// Cluster wide requests are outside of a namespace scope
+	assert.False(t, token.Allows("", "list"))
+}
+
+// TestAPIToken_IsActive tests that expired and revoked tokens are not active
+func TestAPIToken_IsActive(t *testing.T) {
+	past := time.Now().UTC().Add(-time.Minute)
+	future := time.Now().UTC().Add(time.Hour)
+
+	assert.True(t, (&APIToken{}).IsActive())
+	assert.True(t, (&APIToken{ExpiresAt: &future}).IsActive())
+	assert.False(t, (&APIToken{ExpiresAt: &past}).IsActive())
+	assert.False(t, (&APIToken{RevokedAt: &past}).IsActive())
+}
+
+// TestGenerateAPITokenSecret tests that secrets are prefixed and unique
+func TestGenerateAPITokenSecret(t *testing.T) {
+	first, err := generateAPITokenSecret()
+	assert.Nil(t, err)
+	second, err := generateAPITokenSecret()
+	assert.Nil(t, err)
+
+	assert.True(t, IsAPIToken(first))
+	assert.NotEqual(t, first, second)
+	assert.Len(t, HashAPIToken(first), 64)
+}
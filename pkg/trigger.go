@@ -0,0 +1,553 @@
+package v1
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/onepanelio/core/pkg/util/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	// maxTriggerPayloadSize is the largest payload a trigger accepts
+	maxTriggerPayloadSize = 1 << 20
+	// maxStoredTriggerPayloadSize limits the payload kept with an invocation, longer payloads are truncated
+	maxStoredTriggerPayloadSize = 64 << 10
+)
+
+// parseTriggerPath parses a JSONPath expression such as $.repository.name.
+// Expressions in the kubernetes template syntax, like {.repository.name}, are accepted as is.
+func parseTriggerPath(path string) (*jsonpath.JSONPath, error) {
+	expression := strings.TrimSpace(path)
+	if expression == "" {
+		return nil, fmt.Errorf("path is empty")
+	}
+	if !strings.HasPrefix(expression, "{") {
+		expression = "{" + strings.TrimPrefix(expression, "$") + "}"
+	}
+
+	parser := jsonpath.New("trigger").AllowMissingKeys(true)
+	if err := parser.Parse(expression); err != nil {
+		return nil, err
+	}
+
+	return parser, nil
+}
+
+// triggerParameterValue formats a value found in a payload as a parameter value.
+// Strings are used as is, other values are formatted as JSON.
+func triggerParameterValue(value reflect.Value) (string, error) {
+	if value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return "", nil
+	}
+
+	switch typed := value.Interface().(type) {
+	case string:
+		return typed, nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	}
+
+	result, err := json.Marshal(value.Interface())
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+// resolveTriggerParameters returns the parameter values that the mappings select from the JSON payload.
+// An empty payload is treated as an empty object.
+func resolveTriggerParameters(mappings []TriggerParameter, payload []byte) ([]Parameter, error) {
+	var data interface{} = map[string]interface{}{}
+	if len(strings.TrimSpace(string(payload))) > 0 {
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return nil, fmt.Errorf("payload is not valid JSON: %v", err)
+		}
+	}
+
+	parameters := make([]Parameter, 0, len(mappings))
+	for _, mapping := range mappings {
+		parser, err := parseTriggerPath(mapping.Path)
+		if err != nil {
+			return nil, fmt.Errorf("parameter '%v' has an invalid path: %v", mapping.Name, err)
+		}
+
+		results, err := parser.FindResults(data)
+		if err != nil {
+			return nil, fmt.Errorf("parameter '%v': %v", mapping.Name, err)
+		}
+
+		values := make([]reflect.Value, 0)
+		for _, result := range results {
+			values = append(values, result...)
+		}
+
+		var value string
+		switch len(values) {
+		case 0:
+			if mapping.Default == nil {
+				return nil, fmt.Errorf("parameter '%v': %v is not in the payload", mapping.Name, mapping.Path)
+			}
+			value = *mapping.Default
+		case 1:
+			if value, err = triggerParameterValue(values[0]); err != nil {
+				return nil, err
+			}
+		default:
+			// A path that matches several values, like $.commits[*].id, is passed as a JSON array
+			items := make([]interface{}, 0, len(values))
+			for _, item := range values {
+				items = append(items, item.Interface())
+			}
+			result, err := json.Marshal(items)
+			if err != nil {
+				return nil, err
+			}
+			value = string(result)
+		}
+
+		parameters = append(parameters, Parameter{Name: mapping.Name, Value: &value})
+	}
+
+	return parameters, nil
+}
+
+// deriveTriggerCredentials returns the url token and signing key of the trigger.
+// They are HMACs of the trigger's nonce keyed with the system hmac key, so changing the key invalidates them.
+func deriveTriggerCredentials(hmacKey []byte, trigger *Trigger) *TriggerCredentials {
+	derive := func(purpose string) string {
+		mac := hmac.New(sha256.New, hmacKey)
+		mac.Write([]byte(purpose + "/" + trigger.Namespace + "/" + trigger.UID + "/" + trigger.Nonce))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	return &TriggerCredentials{
+		Token:      derive("trigger-token"),
+		SigningKey: derive("trigger-signing-key"),
+	}
+}
+
+// generateTriggerNonce creates a new random nonce for a trigger
+func generateTriggerNonce() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(data), nil
+}
+
+// getTriggerHMACKey returns the system hmac key, triggers can't be used without one
+func (c *Client) getTriggerHMACKey() ([]byte, error) {
+	sysConfig, err := c.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	key := sysConfig.HMACKey()
+	if len(key) == 0 {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Triggers need an hmac key in the system config.")
+	}
+
+	return key, nil
+}
+
+// validateTriggerParameters checks that the mappings have valid paths and set parameters of the workflow template
+func validateTriggerParameters(mappings []TriggerParameter, workflowTemplate *WorkflowTemplate) error {
+	templateParameters, err := ParseParametersFromManifest([]byte(workflowTemplate.Manifest))
+	if err != nil {
+		return util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	names := make(map[string]bool)
+	for _, parameter := range templateParameters {
+		names[parameter.Name] = true
+	}
+
+	for _, mapping := range mappings {
+		if !workflowTemplate.IsSystem && !names[mapping.Name] {
+			return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Workflow template has no parameter '%v'.", mapping.Name))
+		}
+		if _, err := parseTriggerPath(mapping.Path); err != nil {
+			return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Parameter '%v' has an invalid path: %v", mapping.Name, err))
+		}
+	}
+
+	return nil
+}
+
+func triggerSelectBuilder(namespace string) sq.SelectBuilder {
+	return sb.Select(getTriggerColumns("t")...).
+		Columns(`wt.uid "workflow_template.uid"`, `wt.name "workflow_template.name"`, `wtv.version "workflow_template.version"`).
+		From("triggers t").
+		Join("workflow_template_versions wtv ON wtv.id = t.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{"t.namespace": namespace})
+}
+
+// validateArtifactTrigger checks that the namespace of the artifact trigger has an artifact repository to poll
+// and that its prefix is under the keys of the namespace, but not under the keys of workflow outputs.
+// Otherwise the outputs of the workflow executions it launches would launch it again.
+func (c *Client) validateArtifactTrigger(trigger *Trigger) error {
+	if len(trigger.Parameters) == 0 {
+		return util.NewUserError(codes.InvalidArgument, "Artifact triggers need a parameter for the object, e.g. with the path $.key.")
+	}
+	if trigger.ArtifactPrefix == "" {
+		return util.NewUserError(codes.InvalidArgument, "Artifact prefix is required.")
+	}
+
+	config, err := c.GetNamespaceConfig(trigger.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var keyFormat string
+	switch {
+	case config.ArtifactRepository.S3 != nil:
+		keyFormat = config.ArtifactRepository.S3.KeyFormat
+	case config.ArtifactRepository.GCS != nil:
+		keyFormat = config.ArtifactRepository.GCS.KeyFormat
+	default:
+		return util.NewUserError(codes.FailedPrecondition, "Namespace has no artifact repository.")
+	}
+
+	prefix, err := artifactKeyPrefix(keyFormat, trigger.Namespace)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(trigger.ArtifactPrefix, prefix) {
+		return util.NewUserError(codes.PermissionDenied, "Artifact prefix must be under '"+prefix+"'.")
+	}
+	if artifactPrefixOverlapsOutputs(keyFormat, trigger.Namespace, trigger.ArtifactPrefix) {
+		return util.NewUserError(codes.InvalidArgument, "Artifact prefix must not be under the keys of workflow outputs, '"+keyFormat+"'.")
+	}
+
+	return nil
+}
+
+// CreateTrigger creates a trigger for a version of a workflow template, the latest version if none is set.
+// The credentials of webhook triggers are returned so the caller can set up the external system, they can't be retrieved again.
+// Artifact triggers have no credentials.
+func (c *Client) CreateTrigger(trigger *Trigger) (*Trigger, *TriggerCredentials, error) {
+	if trigger.Name == "" {
+		return nil, nil, util.NewUserError(codes.InvalidArgument, "Trigger name is required.")
+	}
+	if trigger.Type == "" {
+		trigger.Type = TriggerTypeWebhook
+	}
+
+	var hmacKey []byte
+	var err error
+	switch trigger.Type {
+	case TriggerTypeWebhook:
+		if hmacKey, err = c.getTriggerHMACKey(); err != nil {
+			return nil, nil, err
+		}
+	case TriggerTypeArtifact:
+		if err := c.validateArtifactTrigger(trigger); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Trigger type must be %v or %v.", TriggerTypeWebhook, TriggerTypeArtifact))
+	}
+
+	workflowTemplate, err := c.GetWorkflowTemplate(trigger.Namespace, trigger.WorkflowTemplate.UID, trigger.WorkflowTemplate.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := validateTriggerParameters(trigger.Parameters, workflowTemplate); err != nil {
+		return nil, nil, err
+	}
+
+	if trigger.Parameters == nil {
+		trigger.Parameters = make([]TriggerParameter, 0)
+	}
+	if trigger.Labels == nil {
+		trigger.Labels = make(types.JSONLabels)
+	}
+	if trigger.UID, err = generateNotificationUID(); err != nil {
+		return nil, nil, err
+	}
+	if trigger.Nonce, err = generateTriggerNonce(); err != nil {
+		return nil, nil, err
+	}
+	if trigger.ParametersBytes, err = json.Marshal(trigger.Parameters); err != nil {
+		return nil, nil, err
+	}
+
+	err = sb.Insert("triggers").
+		SetMap(sq.Eq{
+			"uid":                          trigger.UID,
+			"namespace":                    trigger.Namespace,
+			"name":                         trigger.Name,
+			"type":                         trigger.Type,
+			"workflow_template_version_id": workflowTemplate.WorkflowTemplateVersionID,
+			"nonce":                        trigger.Nonce,
+			"parameters":                   string(trigger.ParametersBytes),
+			"labels":                       trigger.Labels,
+			"artifact_prefix":              trigger.ArtifactPrefix,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&trigger.ID, &trigger.CreatedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": trigger.Namespace,
+			"Name":      trigger.Name,
+			"Error":     err.Error(),
+		}).Error("Unable to create trigger.")
+		return nil, nil, util.NewUserErrorWrap(err, "Trigger")
+	}
+
+	trigger.WorkflowTemplate = &WorkflowTemplate{
+		UID:     workflowTemplate.UID,
+		Name:    workflowTemplate.Name,
+		Version: workflowTemplate.Version,
+	}
+
+	if trigger.Type != TriggerTypeWebhook {
+		return trigger, nil, nil
+	}
+
+	return trigger, deriveTriggerCredentials(hmacKey, trigger), nil
+}
+
+// GetTrigger returns the trigger, or a NotFound error
+func (c *Client) GetTrigger(namespace, uid string) (*Trigger, error) {
+	trigger := &Trigger{}
+	if err := c.DB.Getx(trigger, triggerSelectBuilder(namespace).Where(sq.Eq{"t.uid": uid})); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Trigger not found.")
+		}
+		return nil, err
+	}
+
+	return trigger, json.Unmarshal(trigger.ParametersBytes, &trigger.Parameters)
+}
+
+// ListTriggers returns the triggers of the namespace, newest first
+func (c *Client) ListTriggers(namespace string, paginator *pagination.PaginationRequest) (triggers []*Trigger, err error) {
+	query := triggerSelectBuilder(namespace).
+		OrderBy("t.created_at DESC", "t.id DESC")
+	query = *paginator.ApplyToSelect(&query)
+
+	if err = c.DB.Selectx(&triggers, query); err != nil {
+		return nil, err
+	}
+
+	for _, trigger := range triggers {
+		if err := json.Unmarshal(trigger.ParametersBytes, &trigger.Parameters); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// CountTriggers returns the number of triggers in the namespace
+func (c *Client) CountTriggers(namespace string) (count int, err error) {
+	err = sb.Select("COUNT(*)").
+		From("triggers").
+		Where(sq.Eq{"namespace": namespace}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// DeleteTrigger deletes the trigger along with its invocations, its url stops working
+func (c *Client) DeleteTrigger(namespace, uid string) error {
+	result, err := sb.Delete("triggers").
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Trigger not found.")
+	}
+
+	return nil
+}
+
+// ListTriggerInvocations returns the invocations of the trigger, newest first
+func (c *Client) ListTriggerInvocations(trigger *Trigger, paginator *pagination.PaginationRequest) (invocations []*TriggerInvocation, err error) {
+	query := sb.Select(getTriggerInvocationColumns()...).
+		From("trigger_invocations").
+		Where(sq.Eq{"trigger_id": trigger.ID}).
+		OrderBy("created_at DESC", "id DESC")
+	query = *paginator.ApplyToSelect(&query)
+
+	if err = c.DB.Selectx(&invocations, query); err != nil {
+		return nil, err
+	}
+
+	for _, invocation := range invocations {
+		if err := json.Unmarshal(invocation.ParametersBytes, &invocation.Parameters); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// CountTriggerInvocations returns the number of invocations of the trigger
+func (c *Client) CountTriggerInvocations(trigger *Trigger) (count int, err error) {
+	err = sb.Select("COUNT(*)").
+		From("trigger_invocations").
+		Where(sq.Eq{"trigger_id": trigger.ID}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// recordTriggerInvocation stores the invocation, failing to do so is logged since the workflow execution may already exist
+func (c *Client) recordTriggerInvocation(trigger *Trigger, invocation *TriggerInvocation) {
+	invocation.TriggerID = trigger.ID
+	if len(invocation.Payload) > maxStoredTriggerPayloadSize {
+		invocation.Payload = invocation.Payload[:maxStoredTriggerPayloadSize]
+	}
+	if invocation.Parameters == nil {
+		invocation.Parameters = make([]Parameter, 0)
+	}
+
+	parameters, err := json.Marshal(invocation.Parameters)
+	if err == nil {
+		err = sb.Insert("trigger_invocations").
+			SetMap(sq.Eq{
+				"trigger_id":             invocation.TriggerID,
+				"status":                 invocation.Status,
+				"error":                  invocation.Error,
+				"payload":                invocation.Payload,
+				"parameters":             string(parameters),
+				"workflow_execution_uid": invocation.WorkflowExecutionUID,
+			}).
+			Suffix("RETURNING id, created_at").
+			RunWith(c.DB).
+			QueryRow().
+			Scan(&invocation.ID, &invocation.CreatedAt)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": trigger.Namespace,
+			"Trigger":   trigger.UID,
+			"Error":     err.Error(),
+		}).Error("Unable to record trigger invocation.")
+	}
+}
+
+// InvokeTrigger creates a workflow execution with the parameters that the trigger maps from the payload.
+// The token must be the trigger's url token and the signature must be the payload's signature, the url alone is not enough
+// as it ends up in proxy and access logs.
+// Every invocation of an existing trigger is recorded, payloads of rejected invocations are not kept.
+func (c *Client) InvokeTrigger(namespace, uid, token string, payload []byte, signature string) (*TriggerInvocation, error) {
+	trigger, err := c.GetTrigger(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+	if trigger.Type != TriggerTypeWebhook {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Only webhook triggers can be invoked.")
+	}
+
+	hmacKey, err := c.getTriggerHMACKey()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := deriveTriggerCredentials(hmacKey, trigger)
+	invocation := &TriggerInvocation{Status: TriggerInvocationRejected}
+	if !hmac.Equal([]byte(token), []byte(credentials.Token)) {
+		invocation.Error = "Invalid token."
+		c.recordTriggerInvocation(trigger, invocation)
+		return nil, util.NewUserError(codes.Unauthenticated, "Invalid trigger token.")
+	}
+	if !hmac.Equal([]byte(signature), []byte(signPayload([]byte(credentials.SigningKey), payload))) {
+		invocation.Error = "Invalid signature."
+		c.recordTriggerInvocation(trigger, invocation)
+		return nil, util.NewUserError(codes.Unauthenticated, "Invalid payload signature.")
+	}
+
+	if len(payload) > maxTriggerPayloadSize {
+		invocation.Status = TriggerInvocationFailed
+		invocation.Error = "Payload is too large."
+		c.recordTriggerInvocation(trigger, invocation)
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Payloads can be at most %v bytes.", maxTriggerPayloadSize))
+	}
+
+	return c.launchTrigger(trigger, payload)
+}
+
+// launchTrigger creates a workflow execution with the parameters that the trigger maps from the payload, and records the invocation
+func (c *Client) launchTrigger(trigger *Trigger, payload []byte) (*TriggerInvocation, error) {
+	invocation := &TriggerInvocation{
+		Status:  TriggerInvocationFailed,
+		Payload: string(payload),
+	}
+
+	parameters, err := resolveTriggerParameters(trigger.Parameters, payload)
+	if err != nil {
+		invocation.Error = err.Error()
+		c.recordTriggerInvocation(trigger, invocation)
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+	invocation.Parameters = parameters
+
+	workflowTemplate, err := c.GetWorkflowTemplate(trigger.Namespace, trigger.WorkflowTemplate.UID, trigger.WorkflowTemplate.Version)
+	if err != nil {
+		invocation.Error = err.Error()
+		c.recordTriggerInvocation(trigger, invocation)
+		return nil, err
+	}
+
+	labels := make(map[string]string)
+	for key, value := range trigger.Labels {
+		labels[key] = value
+	}
+	labels[TriggerLabelKey] = trigger.UID
+
+	workflow, err := c.CreateWorkflowExecution(trigger.Namespace, &WorkflowExecution{
+		Parameters: invocation.Parameters,
+		Labels:     labels,
+	}, workflowTemplate)
+	if err != nil {
+		invocation.Error = err.Error()
+		c.recordTriggerInvocation(trigger, invocation)
+		return nil, err
+	}
+
+	invocation.Status = TriggerInvocationSucceeded
+	invocation.WorkflowExecutionUID = &workflow.UID
+	c.recordTriggerInvocation(trigger, invocation)
+
+	return invocation, nil
+}
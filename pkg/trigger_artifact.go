@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// artifactTriggerLockID is the first key of the postgres advisory locks that make one server at a time poll a trigger,
+	// the second key is the id of the trigger
+	artifactTriggerLockID = 51291
+	// maxArtifactTriggerLaunches limits the workflow executions a trigger creates per poll, the other new objects are launched by later polls
+	maxArtifactTriggerLaunches = 20
+	// ArtifactTriggerPollInterval is how often artifact triggers list their prefix
+	ArtifactTriggerPollInterval = time.Minute
+)
+
+// artifactObjectKey identifies a version of an object, overwriting an object changes its etag so it is new again
+func artifactObjectKey(key, etag string) string {
+	return key + "\x00" + etag
+}
+
+// newArtifactObjects returns the objects that are not directories and have not been seen, oldest first
+func newArtifactObjects(objects []*File, seen map[string]bool) []*File {
+	result := make([]*File, 0)
+	for _, object := range objects {
+		if object.Directory || seen[artifactObjectKey(object.Path, object.ETag)] {
+			continue
+		}
+		result = append(result, object)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].LastModified.Equal(result[j].LastModified) {
+			return result[i].LastModified.Before(result[j].LastModified)
+		}
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
+// getSeenArtifactObjects returns the keys of the objects the trigger has seen, see artifactObjectKey
+func (c *Client) getSeenArtifactObjects(trigger *Trigger) (map[string]bool, error) {
+	rows := make([]struct {
+		Key  string
+		ETag string `db:"etag"`
+	}, 0)
+	query := sb.Select("key", "etag").
+		From("trigger_artifact_objects").
+		Where(sq.Eq{"trigger_id": trigger.ID})
+	if err := c.DB.Selectx(&rows, query); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		seen[artifactObjectKey(row.Key, row.ETag)] = true
+	}
+
+	return seen, nil
+}
+
+// markArtifactObjectSeen records that the trigger has seen the object, workflowExecutionUID is nil if nothing was launched for it
+func (c *Client) markArtifactObjectSeen(trigger *Trigger, object *File, workflowExecutionUID *string) error {
+	_, err := sb.Insert("trigger_artifact_objects").
+		SetMap(sq.Eq{
+			"trigger_id":             trigger.ID,
+			"key":                    object.Path,
+			"etag":                   object.ETag,
+			"workflow_execution_uid": workflowExecutionUID,
+		}).
+		Suffix("ON CONFLICT (trigger_id, key, etag) DO NOTHING").
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// pollArtifactTrigger launches the trigger for each new object under its prefix.
+// The objects found by the first poll are only recorded, so creating a trigger doesn't launch a run for every existing object.
+// Objects are marked as seen even if their launch fails, the failed invocation is recorded instead of being retried every poll.
+func (c *Client) pollArtifactTrigger(trigger *Trigger) error {
+	objects, err := c.listArtifactObjects(trigger.Namespace, trigger.ArtifactPrefix, true)
+	if err != nil {
+		return err
+	}
+
+	seen, err := c.getSeenArtifactObjects(trigger)
+	if err != nil {
+		return err
+	}
+
+	launched := 0
+	for _, object := range newArtifactObjects(objects, seen) {
+		if trigger.PolledAt == nil {
+			if err := c.markArtifactObjectSeen(trigger, object, nil); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if launched == maxArtifactTriggerLaunches {
+			break
+		}
+		launched++
+
+		payload, err := json.Marshal(&ArtifactTriggerObject{
+			Key:          object.Path,
+			ETag:         object.ETag,
+			Size:         object.Size,
+			LastModified: object.LastModified.UTC(),
+		})
+		if err != nil {
+			return err
+		}
+
+		var workflowExecutionUID *string
+		invocation, err := c.launchTrigger(trigger, payload)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": trigger.Namespace,
+				"Trigger":   trigger.UID,
+				"Key":       object.Path,
+				"Error":     err.Error(),
+			}).Error("Unable to launch artifact trigger.")
+		} else {
+			workflowExecutionUID = invocation.WorkflowExecutionUID
+		}
+
+		if err := c.markArtifactObjectSeen(trigger, object, workflowExecutionUID); err != nil {
+			return err
+		}
+	}
+
+	_, err = sb.Update("triggers").
+		Set("polled_at", time.Now().UTC()).
+		Where(sq.Eq{"id": trigger.ID}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// PollArtifactTriggers polls the artifact triggers of every namespace.
+// Each trigger is polled by one server at a time, triggers that are being polled by another server are skipped.
+func (c *Client) PollArtifactTriggers() error {
+	var triggers []*Trigger
+	query := sb.Select("t.uid", "t.namespace").
+		From("triggers t").
+		Where(sq.Eq{"t.type": TriggerTypeArtifact})
+	if err := c.DB.Selectx(&triggers, query); err != nil {
+		return err
+	}
+
+	for _, item := range triggers {
+		if err := c.pollArtifactTriggerLocked(item.Namespace, item.UID); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": item.Namespace,
+				"Trigger":   item.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to poll artifact trigger.")
+		}
+	}
+
+	return nil
+}
+
+// pollArtifactTriggerLocked polls the trigger while holding its lock, it is reloaded so it has the latest poll time
+func (c *Client) pollArtifactTriggerLocked(namespace, uid string) error {
+	trigger, err := c.GetTrigger(namespace, uid)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	locked := false
+	if err := tx.QueryRow("SELECT pg_try_advisory_xact_lock($1, $2)", artifactTriggerLockID, trigger.ID).Scan(&locked); err != nil {
+		return err
+	}
+	if !locked {
+		return nil
+	}
+
+	if trigger, err = c.GetTrigger(namespace, uid); err != nil {
+		return err
+	}
+	if err := c.pollArtifactTrigger(trigger); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
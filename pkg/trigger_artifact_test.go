@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewArtifactObjects(t *testing.T) {
+	now := time.Date(2020, 10, 28, 9, 0, 0, 0, time.UTC)
+	objects := []*File{
+		{Path: "data/c.csv", ETag: "3", LastModified: now},
+		{Path: "data/b.csv", ETag: "2", LastModified: now.Add(-time.Minute)},
+		{Path: "data/a.csv", ETag: "1", LastModified: now},
+		{Path: "data/old.csv", ETag: "1", LastModified: now.Add(-time.Hour)},
+		{Path: "data/changed.csv", ETag: "2", LastModified: now.Add(-time.Hour)},
+		{Path: "data/nested", Directory: true, LastModified: now.Add(-2 * time.Hour)},
+	}
+	seen := map[string]bool{
+		artifactObjectKey("data/old.csv", "1"):     true,
+		artifactObjectKey("data/changed.csv", "1"): true,
+	}
+
+	paths := make([]string, 0)
+	for _, object := range newArtifactObjects(objects, seen) {
+		paths = append(paths, object.Path)
+	}
+	assert.Equal(t, []string{"data/changed.csv", "data/b.csv", "data/a.csv", "data/c.csv"}, paths)
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveTriggerParameters(t *testing.T) {
+	payload := []byte(`{
+		"ref": "refs/heads/main",
+		"repository": {"name": "core", "private": false},
+		"size": 42,
+		"ratio": 0.5,
+		"commits": [{"id": "a1"}, {"id": "b2"}]
+	}`)
+
+	parameters, err := resolveTriggerParameters([]TriggerParameter{
+		{Name: "ref", Path: "$.ref"},
+		{Name: "repository", Path: "$.repository.name"},
+		{Name: "private", Path: "{.repository.private}"},
+		{Name: "size", Path: "$.size"},
+		{Name: "ratio", Path: ".ratio"},
+		{Name: "first-commit", Path: "$.commits[0].id"},
+		{Name: "commits", Path: "$.commits[*].id"},
+		{Name: "branch", Path: "$.branch", Default: ptr.String("develop")},
+	}, payload)
+	assert.Nil(t, err)
+
+	values := make(map[string]string)
+	for _, parameter := range parameters {
+		values[parameter.Name] = *parameter.Value
+	}
+	assert.Equal(t, map[string]string{
+		"ref":          "refs/heads/main",
+		"repository":   "core",
+		"private":      "false",
+		"size":         "42",
+		"ratio":        "0.5",
+		"first-commit": "a1",
+		"commits":      `["a1","b2"]`,
+		"branch":       "develop",
+	}, values)
+}
+
+func TestResolveTriggerParameters_Errors(t *testing.T) {
+	_, err := resolveTriggerParameters([]TriggerParameter{{Name: "ref", Path: "$.ref"}}, []byte(`{}`))
+	assert.NotNil(t, err)
+
+	_, err = resolveTriggerParameters([]TriggerParameter{{Name: "ref", Path: "$.ref"}}, []byte(`not json`))
+	assert.NotNil(t, err)
+
+	_, err = resolveTriggerParameters([]TriggerParameter{{Name: "ref", Path: "$.ref["}}, []byte(`{}`))
+	assert.NotNil(t, err)
+
+	// An empty payload is an empty object
+	parameters, err := resolveTriggerParameters([]TriggerParameter{{Name: "ref", Path: "$.ref", Default: ptr.String("main")}}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "main", *parameters[0].Value)
+}
+
+func TestDeriveTriggerCredentials(t *testing.T) {
+	trigger := &Trigger{Namespace: "onepanel", UID: "abc", Nonce: "n1"}
+
+	credentials := deriveTriggerCredentials([]byte("key"), trigger)
+	assert.Len(t, credentials.Token, 64)
+	assert.NotEqual(t, credentials.Token, credentials.SigningKey)
+	assert.Equal(t, credentials, deriveTriggerCredentials([]byte("key"), trigger))
+
+	assert.NotEqual(t, credentials.Token, deriveTriggerCredentials([]byte("other"), trigger).Token)
+
+	rotated := &Trigger{Namespace: "onepanel", UID: "abc", Nonce: "n2"}
+	assert.NotEqual(t, credentials.Token, deriveTriggerCredentials([]byte("key"), rotated).Token)
+}
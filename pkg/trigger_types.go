@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/sql"
+	"github.com/onepanelio/core/pkg/util/types"
+)
+
+// Types of triggers
+const (
+	// TriggerTypeWebhook triggers are invoked by posting a payload to their secret url
+	TriggerTypeWebhook = "webhook"
+	// TriggerTypeArtifact triggers are invoked for each new object under a prefix of the namespace artifact repository.
+	// The payload is the ArtifactTriggerObject that was found.
+	TriggerTypeArtifact = "artifact"
+)
+
+// Statuses of trigger invocations
+const (
+	// TriggerInvocationSucceeded invocations created a workflow execution
+	TriggerInvocationSucceeded = "Succeeded"
+	// TriggerInvocationFailed invocations were authenticated, but their payload could not be used to create a workflow execution
+	TriggerInvocationFailed = "Failed"
+	// TriggerInvocationRejected invocations had an invalid token or signature
+	TriggerInvocationRejected = "Rejected"
+)
+
+// TriggerLabelKey labels the workflow executions created by a trigger, its value is the uid of the trigger
+const TriggerLabelKey = "trigger"
+
+// TriggerSignatureHeader is the optional hex encoded HMAC-SHA256 of the payload, keyed with the trigger signing key, prefixed with sha256=
+const TriggerSignatureHeader = "X-Onepanel-Signature"
+
+// TriggerParameter sets a workflow template parameter to the value at a JSONPath of the payload, e.g. $.repository.name
+type TriggerParameter struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	// Default is used if the path is not in the payload, the invocation fails if there is no default
+	Default *string `json:"default,omitempty"`
+}
+
+// Trigger creates a workflow execution of a workflow template version each time it is invoked.
+// Webhook triggers are invoked by posting a payload to their secret url, external systems don't need kubernetes credentials.
+// Artifact triggers are invoked when objects appear in the artifact repository.
+type Trigger struct {
+	ID               uint64
+	UID              string
+	Namespace        string
+	Name             string
+	Type             string
+	WorkflowTemplate *WorkflowTemplate `db:"workflow_template"`
+	// ArtifactPrefix is the key prefix that artifact triggers watch
+	ArtifactPrefix string     `db:"artifact_prefix"`
+	PolledAt       *time.Time `db:"polled_at"`
+	// Nonce is combined with the system hmac key to derive the url token and signing key
+	Nonce           string
+	Parameters      []TriggerParameter `db:"-"`
+	ParametersBytes []byte             `db:"parameters"`
+	Labels          types.JSONLabels
+	CreatedAt       time.Time  `db:"created_at"`
+	ModifiedAt      *time.Time `db:"modified_at"`
+}
+
+// ArtifactTriggerObject is the payload of artifact trigger invocations, parameters are mapped from it with paths like $.key
+type ArtifactTriggerObject struct {
+	Key          string    `json:"key"`
+	ETag         string    `json:"etag"`
+	Size         int64     `json:"size"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// TriggerCredentials are the secrets needed to invoke a trigger, they are derived rather than stored
+type TriggerCredentials struct {
+	// Token is the secret part of the url of the trigger
+	Token string
+	// SigningKey is the key of the signature of payloads
+	SigningKey string
+}
+
+// TriggerInvocation is a record of a payload posted to a trigger
+type TriggerInvocation struct {
+	ID                   uint64
+	TriggerID            uint64 `db:"trigger_id"`
+	Status               string
+	Error                string `db:"error"`
+	Payload              string
+	Parameters           []Parameter `db:"-"`
+	ParametersBytes      []byte      `db:"parameters"`
+	WorkflowExecutionUID *string     `db:"workflow_execution_uid"`
+	CreatedAt            time.Time   `db:"created_at"`
+}
+
+// getTriggerColumns returns all of the columns for Trigger modified by alias, destination.
+// see formatColumnSelect
+func getTriggerColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "uid", "namespace", "name", "type", "nonce", "parameters", "labels", "artifact_prefix", "polled_at", "created_at", "modified_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// getTriggerInvocationColumns returns all of the columns for TriggerInvocation modified by alias, destination.
+// see formatColumnSelect
+func getTriggerInvocationColumns(aliasAndDestination ...string) []string {
+	columns := []string{"id", "trigger_id", "status", "error", "payload", "parameters", "workflow_execution_uid", "created_at"}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
@@ -38,6 +38,12 @@ func TypeToTableName(value string) string {
 	return ""
 }
 
+// Identity is the Kubernetes user, and the groups they belong to, that a client acts on behalf of.
+type Identity struct {
+	Username string
+	Groups   []string
+}
+
 type Namespace struct {
 	Name   string
 	Labels map[string]string
@@ -56,6 +62,30 @@ type ConfigMap struct {
 type LogEntry struct {
 	Timestamp time.Time
 	Content   string
+	// NodeName, PodName and ContainerName identify the source of entries in aggregated logs
+	NodeName      string
+	PodName       string
+	ContainerName string
+}
+
+// LogOptions filter the log entries that are returned. The zero value returns every entry.
+type LogOptions struct {
+	// TailLines limits the entries to the last lines of the log, if positive
+	TailLines int64
+	// Since excludes entries from before the time
+	Since *time.Time
+	// Filter only keeps entries whose content contains it, or matches it as a regular expression if FilterRegex is set
+	Filter      string
+	FilterRegex bool
+}
+
+// LogPage is a chunk of a log that starts and ends on a line boundary
+type LogPage struct {
+	Entries []*LogEntry
+	// NextOffset is the byte offset of the next page in the log
+	NextOffset int64
+	// Done is true if the end of the log was reached
+	Done bool
 }
 
 type Metric struct {
@@ -31,6 +31,25 @@ func PrependEnvVarToContainer(container *corev1.Container, name, value string) {
 	}, container.Env...)
 }
 
+// PrependFieldEnvVarToContainer adds an environment variable with the value of a field of the pod, such as metadata.name
+func PrependFieldEnvVarToContainer(container *corev1.Container, name, fieldPath string) {
+	for _, e := range container.Env {
+		if e.Name == name {
+			return
+		}
+	}
+	container.Env = append([]corev1.EnvVar{
+		{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: fieldPath,
+				},
+			},
+		},
+	}, container.Env...)
+}
+
 func AddDefaultEnvVarsToContainer(container *corev1.Container) {
 	container.EnvFrom = append(container.EnvFrom, corev1.EnvFromSource{
 		SecretRef: &corev1.SecretEnvSource{
@@ -3,6 +3,8 @@ package util
 import (
 	"errors"
 	"fmt"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/status"
 
 	"github.com/lib/pq"
@@ -13,6 +15,10 @@ import (
 type UserError struct {
 	Code    codes.Code
 	Message string
+	// FieldViolations are returned as BadRequest details so clients can tell which fields are invalid
+	FieldViolations []*errdetails.BadRequest_FieldViolation
+	// Info is returned as a detail so clients can act on the error without parsing the message
+	Info *errdetails.ErrorInfo
 }
 
 // Error returns error messages
@@ -22,7 +28,25 @@ func (e *UserError) Error() string {
 
 // GRPCStatus is used by gRPC to return the correct gRPC status codes
 func (e *UserError) GRPCStatus() *status.Status {
-	return status.New(e.Code, e.Message)
+	st := status.New(e.Code, e.Message)
+
+	details := make([]proto.Message, 0)
+	if len(e.FieldViolations) != 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: e.FieldViolations})
+	}
+	if e.Info != nil {
+		details = append(details, e.Info)
+	}
+	if len(details) == 0 {
+		return st
+	}
+
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return detailed
 }
 
 // NewUserError returns an instance of UserError with the appropriate code and message
@@ -30,6 +54,32 @@ func NewUserError(code codes.Code, message string) error {
 	return &UserError{Code: code, Message: message}
 }
 
+// NewFieldViolationsError returns an InvalidArgument UserError with the field violations as details
+func NewFieldViolationsError(message string, violations []*errdetails.BadRequest_FieldViolation) error {
+	return &UserError{Code: codes.InvalidArgument, Message: message, FieldViolations: violations}
+}
+
+// NewInfoError returns an instance of UserError with an ErrorInfo detail of the reason and metadata
+func NewInfoError(code codes.Code, message, reason string, metadata map[string]string) error {
+	return &UserError{Code: code, Message: message, Info: &errdetails.ErrorInfo{Reason: reason, Metadata: metadata}}
+}
+
+// ErrorInfo returns the ErrorInfo detail of the gRPC error with the reason, or nil if it doesn't have one
+func ErrorInfo(err error, reason string) *errdetails.ErrorInfo {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == reason {
+			return info
+		}
+	}
+
+	return nil
+}
+
 func pqError(err *pq.Error) (code codes.Code) {
 	switch err.Code {
 	case "23505":
@@ -2,16 +2,21 @@ package gcs
 
 import (
 	"cloud.google.com/go/storage"
+	"errors"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/option"
 	"io"
+	"time"
 )
 
 // Client is a struct used for accessing Google Cloud Storage.
 type Client struct {
 	*storage.Client
+	// googleAccessID and privateKey of the service account sign urls, they are empty if the credentials have no private key
+	googleAccessID string
+	privateKey     []byte
 }
 
 // NewClient handles the details of initializing the connection to Google Cloud Storage.
@@ -37,7 +42,13 @@ func NewClient(namespace string, serviceAccountJSON string) (gcsClient *Client,
 		return
 	}
 
-	return &Client{Client: client}, nil
+	gcsClient = &Client{Client: client}
+	if jwtConfig, err := google.JWTConfigFromJSON([]byte(serviceAccountJSON)); err == nil {
+		gcsClient.googleAccessID = jwtConfig.Email
+		gcsClient.privateKey = jwtConfig.PrivateKey
+	}
+
+	return gcsClient, nil
 }
 
 /* GetObject retrieves a specific object from Google Cloud Storage.
@@ -55,3 +66,89 @@ func (c *Client) GetObject(bucket, key string) (stream io.ReadCloser, err error)
 
 	return
 }
+
+// GetObjectRange retrieves an object from Google Cloud Storage starting at the byte offset.
+// A negative length reads until the end of the object.
+func (c *Client) GetObjectRange(bucket, key string, offset, length int64) (stream io.ReadCloser, err error) {
+	ctx := context.Background()
+
+	return c.Client.Bucket(bucket).Object(key).NewRangeReader(ctx, offset, length)
+}
+
+/* IsNotFound returns true if the error is returned for an object that does not exist.
+- Function Name is meant to be consistent with S3's.
+*/
+func IsNotFound(err error) bool {
+	return err == storage.ErrObjectNotExist
+}
+
+/* StatObject retrieves the attributes of an object from Google Cloud Storage.
+- Function Name is meant to be consistent with S3's.
+*/
+func (c *Client) StatObject(bucket, key string) (*storage.ObjectAttrs, error) {
+	ctx := context.Background()
+
+	return c.Client.Bucket(bucket).Object(key).Attrs(ctx)
+}
+
+// UploadObject writes the object to Google Cloud Storage, the object is not created if reading fails.
+// - Function Name is meant to be consistent with S3's.
+func (c *Client) UploadObject(bucket, key string, reader io.Reader, contentType string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	writer := c.Client.Bucket(bucket).Object(key).NewWriter(ctx)
+	writer.ContentType = contentType
+	if _, err := io.Copy(writer, reader); err != nil {
+		// Cancelling the context aborts the upload
+		cancel()
+		writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
+
+// DeleteObject deletes the object from Google Cloud Storage, it is not an error if it does not exist.
+// - Function Name is meant to be consistent with S3's.
+func (c *Client) DeleteObject(bucket, key string) error {
+	ctx := context.Background()
+
+	err := c.Client.Bucket(bucket).Object(key).Delete(ctx)
+	if err == storage.ErrObjectNotExist {
+		return nil
+	}
+
+	return err
+}
+
+// CopyObjectWithinBucket copies the object to destination in the same bucket.
+// - Function Name is meant to be consistent with S3's.
+func (c *Client) CopyObjectWithinBucket(bucket, source, destination string) error {
+	ctx := context.Background()
+	handle := c.Client.Bucket(bucket)
+
+	_, err := handle.Object(destination).CopierFrom(handle.Object(source)).Run(ctx)
+
+	return err
+}
+
+// ErrSigningUnavailable is returned when urls are signed with credentials that have no private key
+var ErrSigningUnavailable = errors.New("service account has no private key to sign urls with")
+
+/* PresignedURL returns a url that allows the http method on the object until it expires, without credentials.
+- Function Name is meant to be consistent with S3's.
+*/
+func (c *Client) PresignedURL(bucket, key, method string, expires time.Duration) (string, error) {
+	if c.googleAccessID == "" || len(c.privateKey) == 0 {
+		return "", ErrSigningUnavailable
+	}
+
+	return storage.SignedURL(bucket, key, &storage.SignedURLOptions{
+		GoogleAccessID: c.googleAccessID,
+		PrivateKey:     c.privateKey,
+		Method:         method,
+		Expires:        time.Now().Add(expires),
+		Scheme:         storage.SigningSchemeV4,
+	})
+}
@@ -5,6 +5,7 @@ import "fmt"
 // API provides methods to generate urls for the API
 type API interface {
 	UpdateWorkspaceStatus(namespace, uid string) string
+	InvokeTrigger(namespace, uid, token string) string
 }
 
 // api is a basic implementation of router.API
@@ -19,6 +20,12 @@ func (a *api) UpdateWorkspaceStatus(namespace, uid string) string {
 	return fmt.Sprintf("%v%v/apis/v1beta1/%v/workspaces/%v/status", a.protocol, a.fqdn, namespace, uid)
 }
 
+// InvokeTrigger generates the secret url that external systems post payloads to
+func (a *api) InvokeTrigger(namespace, uid, token string) string {
+	// <protocol><fqdn>/apis/v1beta1/{namespace}/triggers/{uid}/invoke/{token}
+	return fmt.Sprintf("%v%v/apis/v1beta1/%v/triggers/%v/invoke/%v", a.protocol, a.fqdn, namespace, uid, token)
+}
+
 // NewAPIRouter creates a new api router used to generate urls for the api
 func NewAPIRouter(protocol, fqdn string) (API, error) {
 	return &api{
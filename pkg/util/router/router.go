@@ -8,6 +8,7 @@ import (
 // this can be used to generate urls for workspaces or workflows when they are ready.
 type Web interface {
 	WorkflowExecution(namespace, uid string) string
+	Workspace(namespace, uid string) string
 }
 
 // web is a basic implementation of router.Web
@@ -22,6 +23,12 @@ func (w *web) WorkflowExecution(namespace, uid string) string {
 	return fmt.Sprintf("%v%v/%v/workflows/%v", w.protocol, w.fqdn, namespace, uid)
 }
 
+// Workspace generates a url to view a specific workspace
+func (w *web) Workspace(namespace, uid string) string {
+	// <protocol><fqdn>/<namespace>/workspaces/<uid>
+	return fmt.Sprintf("%v%v/%v/workspaces/%v", w.protocol, w.fqdn, namespace, uid)
+}
+
 // NewWebRouter creates a new web router used to generate urls for the web client
 func NewWebRouter(protocol, fqdn string) (Web, error) {
 	return &web{
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"io"
+	"time"
 
 	minio "github.com/minio/minio-go/v6"
 )
@@ -12,6 +13,8 @@ type Client struct {
 
 type GetObjectOptions = minio.GetObjectOptions
 
+type StatObjectOptions = minio.StatObjectOptions
+
 type Config struct {
 	AccessKey string
 	SecretKey string
@@ -44,3 +47,65 @@ func (c *Client) GetObject(bucket, key string, opts GetObjectOptions) (stream io
 
 	return
 }
+
+// IsNotFound returns true if the error is returned for an object that does not exist
+func IsNotFound(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
+
+// GetObjectRange retrieves length bytes of the object starting at the byte offset.
+// A negative length reads until the end of the object.
+func (c *Client) GetObjectRange(bucket, key string, offset, length int64) (stream io.ReadCloser, err error) {
+	opts := GetObjectOptions{}
+	switch {
+	case length > 0:
+		err = opts.SetRange(offset, offset+length-1)
+	case length < 0 && offset > 0:
+		err = opts.SetRange(offset, 0)
+	}
+	if err != nil {
+		return
+	}
+
+	return c.GetObject(bucket, key, opts)
+}
+
+// uploadPartSize is the size of the parts uploads are split into.
+// Objects of unknown size are buffered one part at a time, which limits them to 10000 parts.
+const uploadPartSize = 16 * 1024 * 1024
+
+// UploadObject writes the object, size is -1 if it is unknown
+func (c *Client) UploadObject(bucket, key string, reader io.Reader, size int64, contentType string) error {
+	_, err := c.Client.PutObject(bucket, key, reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    uploadPartSize,
+	})
+
+	return err
+}
+
+// DeleteObject deletes the object, it is not an error if it does not exist
+func (c *Client) DeleteObject(bucket, key string) error {
+	return c.Client.RemoveObject(bucket, key)
+}
+
+// CopyObjectWithinBucket copies the object to destination in the same bucket, objects larger than 5GiB are copied in parts
+func (c *Client) CopyObjectWithinBucket(bucket, source, destination string) error {
+	dst, err := minio.NewDestinationInfo(bucket, destination, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.Client.ComposeObject(dst, []minio.SourceInfo{minio.NewSourceInfo(bucket, source, nil)})
+}
+
+// PresignedURL returns a url that allows the http method on the object until it expires, without credentials.
+// S3 urls expire after 7 days at most.
+func (c *Client) PresignedURL(bucket, key, method string, expires time.Duration) (string, error) {
+	u, err := c.Client.Presign(method, bucket, key, expires, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
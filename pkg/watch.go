@@ -0,0 +1,431 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	argoprojv1alpha1 "github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/label"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// watchMatches tracks which resources of a watch the client has, so changes are sent as the right type of event.
+// A resource that is known not to match is false, a resource that is not in the map is unknown.
+type watchMatches struct {
+	filter *WatchFilter
+	known  map[string]bool
+	// resumed is true if the watch continues one the client had before, the client may have resources that are unknown
+	resumed bool
+}
+
+// eventType returns the type of the event to send for the change of a resource, it is empty if the client doesn't need it.
+// Resources that stop matching the filter are sent as deleted.
+func (m *watchMatches) eventType(uid, phase string, labels map[string]string, deleted bool) string {
+	matches := !deleted && m.filter.matches(phase, labels)
+	matched, known := m.known[uid]
+	m.known[uid] = matches
+
+	switch {
+	case matches && matched:
+		return WatchEventModified
+	case matches:
+		return WatchEventAdded
+	case matched || (!known && m.resumed):
+		return WatchEventDeleted
+	}
+
+	return ""
+}
+
+// workflowExecutionFromArgo returns the workflow execution of the argo workflow, without its manifest
+func workflowExecutionFromArgo(wf *wfv1.Workflow) *WorkflowExecution {
+	workflowExecution := &WorkflowExecution{
+		CreatedAt: wf.CreationTimestamp.UTC(),
+		UID:       wf.Name,
+		Name:      wf.Name,
+		Namespace: wf.Namespace,
+		Phase:     argoWorkflowExecutionPhase(wf),
+		Labels:    label.RemovePrefix(label.TagPrefix, label.FilterByPrefix(label.TagPrefix, wf.Labels)),
+	}
+
+	if !wf.Status.StartedAt.IsZero() {
+		workflowExecution.StartedAt = ptr.Time(wf.Status.StartedAt.UTC())
+	}
+	if !wf.Status.FinishedAt.IsZero() {
+		workflowExecution.FinishedAt = ptr.Time(wf.Status.FinishedAt.UTC())
+	}
+
+	if templateUID := wf.Labels[workflowTemplateUIDLabelKey]; templateUID != "" {
+		version, _ := strconv.ParseInt(wf.Labels[workflowTemplateVersionLabelKey], 10, 64)
+		workflowExecution.WorkflowTemplate = &WorkflowTemplate{
+			UID:     templateUID,
+			Version: version,
+		}
+	}
+
+	return workflowExecution
+}
+
+// errWatchStopped is returned by workflowWatch.run when it stops because it was asked to
+var errWatchStopped = errors.New("watch stopped")
+
+// errWatchVersionExpired is returned by workflowWatch.run when the version it resumes from has expired before it listed the workflows.
+// The deletions since that version can't be found, so the client has to start over.
+var errWatchVersionExpired = util.NewUserError(codes.OutOfRange, "Resource version has expired, watch again without it.")
+
+// workflowWatch follows the changes of the workflows that match its list options.
+// It resumes after resourceVersion each time the watch times out. If the version has expired, the workflows are listed again
+// and the ones that changed or were deleted in the meantime are passed on, so no change is lost.
+// That needs the workflows the watch saw before, a watch that resumes from a version the client had fails with errWatchVersionExpired instead.
+type workflowWatch struct {
+	workflows       argoprojv1alpha1.WorkflowInterface
+	listOptions     metav1.ListOptions
+	resourceVersion string
+	// current are the workflows as the watch last saw them, by name
+	current map[string]*wfv1.Workflow
+	// listed is true once current has every workflow as of resourceVersion
+	listed bool
+	stopCh <-chan struct{}
+}
+
+// newWorkflowWatch returns a watch that resumes after resourceVersion, it starts with a list if resourceVersion is empty
+func newWorkflowWatch(workflows argoprojv1alpha1.WorkflowInterface, listOptions metav1.ListOptions, resourceVersion string, stopCh <-chan struct{}) *workflowWatch {
+	return &workflowWatch{
+		workflows:       workflows,
+		listOptions:     listOptions,
+		resourceVersion: resourceVersion,
+		current:         make(map[string]*wfv1.Workflow),
+		stopCh:          stopCh,
+	}
+}
+
+// isWatchExpired returns true if the error is because the version a watch resumes from is too old
+func isWatchExpired(err error) bool {
+	return apierrors.IsGone(err) || apierrors.IsResourceExpired(err)
+}
+
+// run passes each change to handle until stopCh is closed or handle returns false, which return errWatchStopped,
+// or the watch fails.
+func (w *workflowWatch) run(handle func(wf *wfv1.Workflow, deleted bool) bool) error {
+	if w.resourceVersion == "" {
+		if err := w.relist(handle); err != nil {
+			return err
+		}
+	}
+
+	for {
+		err := w.watch(handle)
+		if isWatchExpired(err) {
+			if !w.listed {
+				return errWatchVersionExpired
+			}
+			err = w.relist(handle)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// relist lists the workflows and passes on the ones that are new, changed or gone since the watch last saw them
+func (w *workflowWatch) relist(handle func(wf *wfv1.Workflow, deleted bool) bool) error {
+	list, err := w.workflows.List(w.listOptions)
+	if err != nil {
+		return err
+	}
+
+	listed := make(map[string]bool)
+	for i := range list.Items {
+		wf := &list.Items[i]
+		listed[wf.Name] = true
+		if previous, ok := w.current[wf.Name]; ok && previous.ResourceVersion == wf.ResourceVersion {
+			continue
+		}
+
+		w.current[wf.Name] = wf
+		if !handle(wf, false) {
+			return errWatchStopped
+		}
+	}
+
+	for name, wf := range w.current {
+		if listed[name] {
+			continue
+		}
+
+		delete(w.current, name)
+		if !handle(wf, true) {
+			return errWatchStopped
+		}
+	}
+
+	w.resourceVersion = list.ResourceVersion
+	w.listed = true
+
+	return nil
+}
+
+// watch passes on the changes after resourceVersion until the watch times out, which returns nil
+func (w *workflowWatch) watch(handle func(wf *wfv1.Workflow, deleted bool) bool) error {
+	listOptions := w.listOptions
+	listOptions.ResourceVersion = w.resourceVersion
+	watcher, err := w.workflows.Watch(listOptions)
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-w.stopCh:
+			return errWatchStopped
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+
+			if event.Type == watch.Error {
+				return apierrors.FromObject(event.Object)
+			}
+
+			wf, ok := event.Object.(*wfv1.Workflow)
+			if !ok {
+				continue
+			}
+			w.resourceVersion = wf.ResourceVersion
+
+			switch event.Type {
+			case watch.Bookmark:
+				continue
+			case watch.Deleted:
+				delete(w.current, wf.Name)
+			default:
+				w.current[wf.Name] = wf
+			}
+
+			if !handle(wf, event.Type == watch.Deleted) {
+				return errWatchStopped
+			}
+		}
+	}
+}
+
+// WatchWorkflowExecutions streams the changes of the workflow executions of the namespace that pass the filter until stopCh is closed.
+// If resourceVersion is empty, the current workflow executions are sent first as added,
+// otherwise the watch resumes after the change with that version.
+// Queued workflow executions are sent once they are dispatched.
+// If the watch fails, a WatchEventError event is sent before the channel is closed,
+// its error is OutOfRange if the version has expired and the client has to watch again without it.
+func (c *Client) WatchWorkflowExecutions(namespace string, filter *WatchFilter, resourceVersion string, stopCh <-chan struct{}) (<-chan *WorkflowExecutionWatchEvent, error) {
+	workflows := c.ArgoprojV1alpha1().Workflows(namespace)
+	listOptions := metav1.ListOptions{
+		LabelSelector: workflowTemplateUIDLabelKey,
+	}
+
+	matches := &watchMatches{
+		filter:  filter,
+		known:   make(map[string]bool),
+		resumed: resourceVersion != "",
+	}
+	workflowWatch := newWorkflowWatch(workflows, listOptions, resourceVersion, stopCh)
+
+	// Fail before streaming if the workflow executions can't be listed
+	var initial []wfv1.Workflow
+	if resourceVersion == "" {
+		list, err := workflows.List(listOptions)
+		if err != nil {
+			return nil, util.NewUserErrorWrap(err, "Workflow executions")
+		}
+		initial = list.Items
+		for i := range initial {
+			workflowWatch.current[initial[i].Name] = &initial[i]
+		}
+		workflowWatch.resourceVersion = list.ResourceVersion
+		workflowWatch.listed = true
+	}
+
+	events := make(chan *WorkflowExecutionWatchEvent)
+	send := func(wf *wfv1.Workflow, deleted bool) bool {
+		workflowExecution := workflowExecutionFromArgo(wf)
+		eventType := matches.eventType(workflowExecution.UID, string(workflowExecution.Phase), workflowExecution.Labels, deleted)
+		if eventType == "" {
+			return true
+		}
+
+		select {
+		case events <- &WorkflowExecutionWatchEvent{Type: eventType, WorkflowExecution: workflowExecution, ResourceVersion: wf.ResourceVersion}:
+			return true
+		case <-stopCh:
+			return false
+		}
+	}
+
+	go func() {
+		defer close(events)
+
+		for i := range initial {
+			if !send(&initial[i], false) {
+				return
+			}
+		}
+
+		if err := workflowWatch.run(send); err != nil && err != errWatchStopped {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Error":     err.Error(),
+			}).Error("Workflow execution watch failed.")
+
+			if err != errWatchVersionExpired {
+				err = util.NewUserError(codes.Unavailable, "Workflow execution watch failed.")
+			}
+
+			select {
+			case events <- &WorkflowExecutionWatchEvent{Type: WatchEventError, Err: err}:
+			case <-stopCh:
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// String encodes the cursor for clients
+func (c *workspaceWatchCursor) String() string {
+	return fmt.Sprintf("%d-%d", c.TxID, c.ID)
+}
+
+// parseWorkspaceWatchCursor decodes a cursor encoded by workspaceWatchCursor.String
+func parseWorkspaceWatchCursor(value string) (*workspaceWatchCursor, error) {
+	parts := strings.Split(value, "-")
+	if len(parts) != 2 {
+		return nil, util.NewUserError(codes.InvalidArgument, "Invalid cursor.")
+	}
+
+	txID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Invalid cursor.")
+	}
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Invalid cursor.")
+	}
+
+	return &workspaceWatchCursor{
+		TxID: txID,
+		ID:   id,
+	}, nil
+}
+
+// listChangedWorkspaces returns the workspaces of the namespace that changed after the cursor, in the order they changed.
+// Only changes of transactions older than every running transaction are loaded, so a change that commits late
+// is never behind the cursor. A long running transaction delays the changes until it finishes.
+func (c *Client) listChangedWorkspaces(namespace string, cursor *workspaceWatchCursor) (workspaces []*changedWorkspace, err error) {
+	query := sb.Select(getWorkspaceColumns("w")...).
+		Columns(getWorkspaceStatusColumns("w", "status")...).
+		Columns(getWorkspaceTemplateColumns("wt", "workspace_template")...).
+		Columns("w.change_txid").
+		From("workspaces w").
+		Join("workspace_templates wt ON wt.id = w.workspace_template_id").
+		Where(sq.Eq{"w.namespace": namespace}).
+		Where("w.change_txid < txid_snapshot_xmin(txid_current_snapshot())").
+		OrderBy("w.change_txid", "w.id").
+		Limit(workspaceWatchBatchSize)
+	if cursor != nil {
+		query = query.Where("(w.change_txid, w.id) > (?, ?)", cursor.TxID, cursor.ID)
+	}
+
+	err = c.DB.Selectx(&workspaces, query)
+
+	return
+}
+
+// WatchWorkspaces streams the changes of the workspaces of the namespace that pass the filter until stopCh is closed.
+// Terminated workspaces are only sent if the filter is for their phase, like ListWorkspaces.
+// If cursor is empty, the current workspaces are sent first as added, otherwise the watch resumes after the change with that cursor.
+// If the watch fails, a WatchEventError event is sent before the channel is closed.
+func (c *Client) WatchWorkspaces(namespace string, filter *WatchFilter, cursor string, stopCh <-chan struct{}) (<-chan *WorkspaceWatchEvent, error) {
+	var position *workspaceWatchCursor
+	if cursor != "" {
+		var err error
+		if position, err = parseWorkspaceWatchCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	matches := &watchMatches{
+		filter:  filter,
+		known:   make(map[string]bool),
+		resumed: position != nil,
+	}
+
+	// Fail before streaming if the workspaces can't be loaded
+	workspaces, err := c.listChangedWorkspaces(namespace, position)
+	if err != nil {
+		return nil, util.NewUserErrorWrap(err, "Workspaces")
+	}
+
+	events := make(chan *WorkspaceWatchEvent)
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(workspaceWatchInterval)
+		defer ticker.Stop()
+
+		for {
+			for _, workspace := range workspaces {
+				position = &workspaceWatchCursor{
+					TxID: workspace.ChangeTxID,
+					ID:   workspace.ID,
+				}
+
+				terminated := workspace.Status.Phase == WorkspaceTerminated && (filter == nil || filter.Phase == "")
+				eventType := matches.eventType(workspace.UID, string(workspace.Status.Phase), workspace.Labels, terminated)
+				if eventType == "" {
+					continue
+				}
+
+				select {
+				case events <- &WorkspaceWatchEvent{Type: eventType, Workspace: workspace.Workspace, Cursor: position.String()}:
+				case <-stopCh:
+					return
+				}
+			}
+
+			// A full batch means there are more changes to load right away
+			if len(workspaces) < workspaceWatchBatchSize {
+				select {
+				case <-ticker.C:
+				case <-stopCh:
+					return
+				}
+			}
+
+			workspaces, err = c.listChangedWorkspaces(namespace, position)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": namespace,
+					"Error":     err.Error(),
+				}).Error("Unable to watch workspaces.")
+
+				select {
+				case events <- &WorkspaceWatchEvent{Type: WatchEventError, Err: util.NewUserError(codes.Unavailable, "Workspace watch failed.")}:
+				case <-stopCh:
+				}
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
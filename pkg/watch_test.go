@@ -0,0 +1,353 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	argoFake "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestWatchMatches_EventType(t *testing.T) {
+	matches := &watchMatches{
+		filter: &WatchFilter{
+			Labels: []*Label{{Key: "team", Value: "vision"}},
+			Phase:  "Running",
+		},
+		known: make(map[string]bool),
+	}
+	labels := map[string]string{"team": "vision"}
+
+	assert.Equal(t, "", matches.eventType("a", "Pending", labels, false))
+	assert.Equal(t, WatchEventAdded, matches.eventType("a", "Running", labels, false))
+	assert.Equal(t, WatchEventModified, matches.eventType("a", "Running", labels, false))
+	assert.Equal(t, WatchEventDeleted, matches.eventType("a", "Running", map[string]string{}, false))
+	assert.Equal(t, "", matches.eventType("a", "Succeeded", labels, false))
+	assert.Equal(t, WatchEventAdded, matches.eventType("b", "Running", labels, false))
+	assert.Equal(t, WatchEventDeleted, matches.eventType("b", "Running", labels, true))
+
+	// A resumed watch doesn't know what the client has, so changes that don't match are sent once as deleted
+	matches = &watchMatches{filter: &WatchFilter{Phase: "Running"}, known: make(map[string]bool), resumed: true}
+	assert.Equal(t, WatchEventDeleted, matches.eventType("c", "Succeeded", nil, false))
+	assert.Equal(t, "", matches.eventType("c", "Succeeded", nil, false))
+}
+
+func TestWorkspaceWatchCursor(t *testing.T) {
+	cursor := &workspaceWatchCursor{
+		TxID: 1234567,
+		ID:   42,
+	}
+
+	parsed, err := parseWorkspaceWatchCursor(cursor.String())
+	assert.Nil(t, err)
+	assert.Equal(t, cursor, parsed)
+
+	for _, value := range []string{"", "42", "a-1", "1-b", "1-2-3"} {
+		_, err := parseWorkspaceWatchCursor(value)
+		assert.NotNil(t, err, value)
+	}
+}
+
+func TestWorkflowExecutionFromArgo(t *testing.T) {
+	startedAt := time.Date(2020, 10, 29, 9, 0, 0, 0, time.UTC)
+	workflowExecution := workflowExecutionFromArgo(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "train-abc12",
+			Namespace: "onepanel",
+			Labels: map[string]string{
+				workflowTemplateUIDLabelKey:     "train",
+				workflowTemplateVersionLabelKey: "1603962000",
+				"tags.onepanel.io/team":         "vision",
+			},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase:     wfv1.NodeRunning,
+			StartedAt: metav1.NewTime(startedAt),
+		},
+	})
+
+	assert.Equal(t, "train-abc12", workflowExecution.UID)
+	assert.Equal(t, wfv1.NodeRunning, workflowExecution.Phase)
+	assert.Equal(t, map[string]string{"team": "vision"}, map[string]string(workflowExecution.Labels))
+	assert.Equal(t, startedAt, *workflowExecution.StartedAt)
+	assert.Nil(t, workflowExecution.FinishedAt)
+	assert.Equal(t, "train", workflowExecution.WorkflowTemplate.UID)
+	assert.Equal(t, int64(1603962000), workflowExecution.WorkflowTemplate.Version)
+}
+
+// fakeWorkflowWatches replaces the watches of the fake argo clientset with ones the test sends the events of
+type fakeWorkflowWatches struct {
+	watchers         chan *watch.FakeWatcher
+	resourceVersions chan string
+}
+
+func newFakeWorkflowWatches(argoClient *argoFake.Clientset) *fakeWorkflowWatches {
+	watches := &fakeWorkflowWatches{
+		watchers:         make(chan *watch.FakeWatcher, 10),
+		resourceVersions: make(chan string, 10),
+	}
+	argoClient.PrependWatchReactor("workflows", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		watcher := watch.NewFake()
+		watches.resourceVersions <- action.(k8stesting.WatchActionImpl).GetWatchRestrictions().ResourceVersion
+		watches.watchers <- watcher
+		return true, watcher, nil
+	})
+
+	return watches
+}
+
+// next returns the next watch that is started and the version it resumes from
+func (w *fakeWorkflowWatches) next(t *testing.T) (*watch.FakeWatcher, string) {
+	select {
+	case watcher := <-w.watchers:
+		return watcher, <-w.resourceVersions
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch was not started")
+	}
+
+	return nil, ""
+}
+
+func newWatchTestWorkflow(name, resourceVersion string, phase wfv1.NodePhase) *wfv1.Workflow {
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "onepanel",
+			ResourceVersion: resourceVersion,
+			Labels: map[string]string{
+				workflowTemplateUIDLabelKey:     "train",
+				workflowTemplateVersionLabelKey: "1",
+			},
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func newWatchTestClient(objects ...runtime.Object) (*Client, *fakeWorkflowWatches) {
+	argoClient := argoFake.NewSimpleClientset(objects...)
+	watches := newFakeWorkflowWatches(argoClient)
+
+	return &Client{argoprojV1alpha1: argoClient.ArgoprojV1alpha1()}, watches
+}
+
+type handledWorkflow struct {
+	Name            string
+	ResourceVersion string
+	Deleted         bool
+}
+
+func receiveHandledWorkflow(t *testing.T, handled <-chan handledWorkflow) handledWorkflow {
+	select {
+	case result := <-handled:
+		return result
+	case <-time.After(5 * time.Second):
+		t.Fatal("change was not handled")
+	}
+
+	return handledWorkflow{}
+}
+
+func TestWorkflowWatch_Relist(t *testing.T) {
+	c, watches := newWatchTestClient(
+		newWatchTestWorkflow("a", "1", wfv1.NodeRunning),
+		newWatchTestWorkflow("b", "1", wfv1.NodeRunning),
+	)
+	workflows := c.ArgoprojV1alpha1().Workflows("onepanel")
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	handled := make(chan handledWorkflow, 10)
+	workflowWatch := newWorkflowWatch(workflows, metav1.ListOptions{}, "1", stopCh)
+	workflowWatch.current["a"] = newWatchTestWorkflow("a", "1", wfv1.NodeRunning)
+	workflowWatch.current["b"] = newWatchTestWorkflow("b", "1", wfv1.NodeRunning)
+	workflowWatch.listed = true
+	go func() {
+		_ = workflowWatch.run(func(wf *wfv1.Workflow, deleted bool) bool {
+			handled <- handledWorkflow{Name: wf.Name, ResourceVersion: wf.ResourceVersion, Deleted: deleted}
+			return true
+		})
+	}()
+
+	watcher, resourceVersion := watches.next(t)
+	assert.Equal(t, "1", resourceVersion)
+
+	watcher.Modify(newWatchTestWorkflow("b", "2", wfv1.NodeRunning))
+	assert.Equal(t, handledWorkflow{Name: "b", ResourceVersion: "2"}, receiveHandledWorkflow(t, handled))
+
+	// While the watch is gone, a is deleted, b changes and c is created
+	_, err := workflows.Update(newWatchTestWorkflow("b", "4", wfv1.NodeSucceeded))
+	assert.Nil(t, err)
+	assert.Nil(t, workflows.Delete("a", nil))
+	_, err = workflows.Create(newWatchTestWorkflow("c", "3", wfv1.NodePending))
+	assert.Nil(t, err)
+
+	watcher.Error(&metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   410,
+		Reason: metav1.StatusReasonExpired,
+	})
+
+	results := map[string]handledWorkflow{}
+	for i := 0; i < 3; i++ {
+		result := receiveHandledWorkflow(t, handled)
+		results[result.Name] = result
+	}
+	assert.Equal(t, map[string]handledWorkflow{
+		"a": {Name: "a", ResourceVersion: "1", Deleted: true},
+		"b": {Name: "b", ResourceVersion: "4"},
+		"c": {Name: "c", ResourceVersion: "3"},
+	}, results)
+
+	// The watch continues after the list
+	watcher, _ = watches.next(t)
+	watcher.Modify(newWatchTestWorkflow("c", "5", wfv1.NodeRunning))
+	assert.Equal(t, handledWorkflow{Name: "c", ResourceVersion: "5"}, receiveHandledWorkflow(t, handled))
+}
+
+func TestWorkflowWatch_Resume(t *testing.T) {
+	c, watches := newWatchTestClient(newWatchTestWorkflow("a", "1", wfv1.NodeRunning))
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	handled := make(chan handledWorkflow, 10)
+	workflowWatch := newWorkflowWatch(c.ArgoprojV1alpha1().Workflows("onepanel"), metav1.ListOptions{}, "7", stopCh)
+	go func() {
+		_ = workflowWatch.run(func(wf *wfv1.Workflow, deleted bool) bool {
+			handled <- handledWorkflow{Name: wf.Name, ResourceVersion: wf.ResourceVersion, Deleted: deleted}
+			return true
+		})
+	}()
+
+	watcher, resourceVersion := watches.next(t)
+	assert.Equal(t, "7", resourceVersion)
+	watcher.Modify(newWatchTestWorkflow("a", "8", wfv1.NodeRunning))
+	assert.Equal(t, handledWorkflow{Name: "a", ResourceVersion: "8"}, receiveHandledWorkflow(t, handled))
+
+	// A watch that times out resumes after the last change
+	watcher.Stop()
+	_, resourceVersion = watches.next(t)
+	assert.Equal(t, "8", resourceVersion)
+}
+
+func TestClient_WatchWorkflowExecution(t *testing.T) {
+	c, watches := newWatchTestClient(newWatchTestWorkflow("a", "3", wfv1.NodePending))
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	// The client has version 3, so only the changes after it are sent, even though the workflow is not running yet
+	workflowExecutions, err := c.WatchWorkflowExecution("onepanel", "a", "3", stopCh)
+	assert.Nil(t, err)
+
+	watcher, resourceVersion := watches.next(t)
+	assert.Equal(t, "3", resourceVersion)
+
+	watcher.Modify(newWatchTestWorkflow("a", "4", wfv1.NodeRunning))
+	workflowExecution := <-workflowExecutions
+	assert.Equal(t, "4", workflowExecution.ResourceVersion)
+
+	succeeded := newWatchTestWorkflow("a", "5", wfv1.NodeSucceeded)
+	succeeded.Status.FinishedAt = metav1.Now()
+	watcher.Modify(succeeded)
+	workflowExecution = <-workflowExecutions
+	assert.Equal(t, "5", workflowExecution.ResourceVersion)
+
+	_, ok := <-workflowExecutions
+	assert.False(t, ok)
+
+	_, err = c.WatchWorkflowExecution("onepanel", "missing", "", stopCh)
+	assert.NotNil(t, err)
+}
+
+func TestClient_WatchWorkflowExecutions(t *testing.T) {
+	c, watches := newWatchTestClient(
+		newWatchTestWorkflow("a", "1", wfv1.NodeRunning),
+		newWatchTestWorkflow("b", "2", wfv1.NodePending),
+	)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	events, err := c.WatchWorkflowExecutions("onepanel", &WatchFilter{Phase: string(wfv1.NodeRunning)}, "", stopCh)
+	assert.Nil(t, err)
+
+	event := <-events
+	assert.Equal(t, WatchEventAdded, event.Type)
+	assert.Equal(t, "a", event.WorkflowExecution.UID)
+
+	watcher, _ := watches.next(t)
+	watcher.Modify(newWatchTestWorkflow("b", "3", wfv1.NodeRunning))
+	event = <-events
+	assert.Equal(t, WatchEventAdded, event.Type)
+	assert.Equal(t, "b", event.WorkflowExecution.UID)
+	assert.Equal(t, "3", event.ResourceVersion)
+
+	watcher.Modify(newWatchTestWorkflow("a", "4", wfv1.NodeSucceeded))
+	event = <-events
+	assert.Equal(t, WatchEventDeleted, event.Type)
+	assert.Equal(t, "a", event.WorkflowExecution.UID)
+}
+
+func TestClient_WatchWorkflowExecutions_Error(t *testing.T) {
+	c, watches := newWatchTestClient()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	events, err := c.WatchWorkflowExecutions("onepanel", nil, "", stopCh)
+	assert.Nil(t, err)
+
+	watcher, _ := watches.next(t)
+	watcher.Error(&metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   500,
+		Reason: metav1.StatusReasonInternalError,
+	})
+
+	// The failure is sent instead of ending the watch as if it was done
+	event := <-events
+	assert.Equal(t, WatchEventError, event.Type)
+	userErr, ok := event.Err.(*util.UserError)
+	if assert.True(t, ok) {
+		assert.Equal(t, codes.Unavailable, userErr.Code)
+	}
+
+	_, ok = <-events
+	assert.False(t, ok)
+}
+
+func TestClient_WatchWorkflowExecutions_Expired(t *testing.T) {
+	c, watches := newWatchTestClient(newWatchTestWorkflow("a", "8", wfv1.NodeRunning))
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	events, err := c.WatchWorkflowExecutions("onepanel", nil, "7", stopCh)
+	assert.Nil(t, err)
+
+	watcher, _ := watches.next(t)
+	watcher.Error(&metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   410,
+		Reason: metav1.StatusReasonExpired,
+	})
+
+	// The watch doesn't know which workflow executions the client has, so it can't send the ones deleted since version 7
+	event := <-events
+	assert.Equal(t, WatchEventError, event.Type)
+	userErr, ok := event.Err.(*util.UserError)
+	if assert.True(t, ok) {
+		assert.Equal(t, codes.OutOfRange, userErr.Code)
+	}
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"time"
+)
+
+// Types of watch events
+const (
+	WatchEventAdded     = "ADDED"
+	WatchEventModified  = "MODIFIED"
+	WatchEventDeleted   = "DELETED"
+	WatchEventHeartbeat = "HEARTBEAT"
+	// WatchEventError is the last event of a watch that failed, its Err is set
+	WatchEventError = "ERROR"
+)
+
+const (
+	// WatchHeartbeatInterval is how often watches send a heartbeat, so clients and proxies know the stream is alive
+	WatchHeartbeatInterval = 30 * time.Second
+	// workspaceWatchInterval is how often workspace watches look for changed workspaces
+	workspaceWatchInterval = 2 * time.Second
+	// workspaceWatchBatchSize limits the changed workspaces loaded at once
+	workspaceWatchBatchSize = 100
+)
+
+// WatchFilter limits the resources of a watch to the ones with all of the labels and the phase, if they are set
+type WatchFilter struct {
+	Labels []*Label
+	Phase  string
+}
+
+// matches returns true if the phase and labels pass the filter
+func (f *WatchFilter) matches(phase string, labels map[string]string) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.Phase != "" && f.Phase != phase {
+		return false
+	}
+
+	for _, l := range f.Labels {
+		if value, ok := labels[l.Key]; !ok || value != l.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WorkflowExecutionWatchEvent is a change of a workflow execution of a namespace watch
+type WorkflowExecutionWatchEvent struct {
+	Type              string
+	WorkflowExecution *WorkflowExecution
+	// ResourceVersion resumes the watch after this event
+	ResourceVersion string
+	// Err is why the watch failed, for WatchEventError events
+	Err error
+}
+
+// WorkspaceWatchEvent is a change of a workspace of a namespace watch
+type WorkspaceWatchEvent struct {
+	Type      string
+	Workspace *Workspace
+	// Cursor resumes the watch after this event
+	Cursor string
+	// Err is why the watch failed, for WatchEventError events
+	Err error
+}
+
+// workspaceWatchCursor is the position of a workspace watch, the last change it sent.
+// Changes are ordered by the id of the transaction that made them, then by workspace id.
+type workspaceWatchCursor struct {
+	TxID uint64
+	ID   uint64
+}
+
+// changedWorkspace is a workspace loaded by a watch, with the id of the transaction that last changed it
+type changedWorkspace struct {
+	*Workspace
+	ChangeTxID uint64 `db:"change_txid"`
+}
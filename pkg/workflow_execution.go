@@ -1,7 +1,6 @@
 package v1
 
 import (
-	"bufio"
 	"cloud.google.com/go/storage"
 	"database/sql"
 	"encoding/json"
@@ -18,7 +17,6 @@ import (
 	"gopkg.in/yaml.v2"
 	"io"
 	"io/ioutil"
-	"k8s.io/apimachinery/pkg/watch"
 	"net/http"
 	"strconv"
 	"strings"
@@ -36,13 +34,13 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 )
 
 var (
-	readEndOffset                   = env.GetEnv("ARTIFACT_RERPOSITORY_OBJECT_RANGE", "-102400")
 	workflowTemplateUIDLabelKey     = "onepanel.io/workflow-template-uid"
 	workflowTemplateVersionLabelKey = "onepanel.io/workflow-template-version"
 )
@@ -94,7 +92,7 @@ func applyWorkflowExecutionFilter(sb sq.SelectBuilder, request *request.Request)
 	case "running":
 		sb = sb.Where(sq.Eq{
 			"we.finished_at": nil,
-			"we.phase":       []string{"Running", "Pending"},
+			"we.phase":       []string{"Running", "Pending", string(WorkflowExecutionSuspended)},
 		})
 	case "completed":
 		sb = sb.Where(sq.NotEq{
@@ -112,6 +110,10 @@ func applyWorkflowExecutionFilter(sb sq.SelectBuilder, request *request.Request)
 		sb = sb.Where(sq.Eq{
 			"we.phase": "Terminated",
 		})
+	case "queued":
+		sb = sb.Where(sq.Eq{
+			"we.phase": WorkflowExecutionQueued,
+		})
 	default:
 		return sb, fmt.Errorf("unknown workflow execution phase filter '%v'", filter.Phase)
 	}
@@ -225,6 +227,8 @@ func injectEnvironmentVariables(container *corev1.Container, systemConfig System
 	env.PrependEnvVarToContainer(container, "ONEPANEL_PROVIDER", systemConfig["ONEPANEL_PROVIDER"])
 	env.PrependEnvVarToContainer(container, "ONEPANEL_RESOURCE_NAMESPACE", "{{workflow.namespace}}")
 	env.PrependEnvVarToContainer(container, "ONEPANEL_RESOURCE_UID", "{{workflow.name}}")
+	// Argo names pods after their node, steps use it to log metrics
+	env.PrependFieldEnvVarToContainer(container, "ONEPANEL_NODE_ID", "metadata.name")
 }
 
 func (c *Client) injectAutomatedFields(namespace string, wf *wfv1.Workflow, opts *WorkflowExecutionOptions) (err error) {
@@ -396,18 +400,36 @@ func (c *Client) createWorkflow(namespace string, workflowTemplateID uint64, wor
 		wf.ObjectMeta.Labels = opts.Labels
 	}
 
-	if err = injectWorkflowExecutionStatusCaller(wf, wfv1.NodeRunning); err != nil {
-		return nil, err
-	}
+	// The workflow execution controller keeps the status up to date, the callers only report it sooner
+	if env.GetEnv("WORKFLOW_EXECUTION_STATUS_CALLERS", "true") != "false" {
+		if err = injectWorkflowExecutionStatusCaller(wf, wfv1.NodeRunning); err != nil {
+			return nil, err
+		}
 
-	if err = injectExitHandlerWorkflowExecutionStatistic(wf, &workflowTemplateID); err != nil {
-		return nil, err
+		if err = injectExitHandlerWorkflowExecutionStatistic(wf, &workflowTemplateID); err != nil {
+			return nil, err
+		}
 	}
 
 	if err = c.injectAutomatedFields(namespace, wf, opts); err != nil {
 		return nil, err
 	}
 
+	if opts.Queue {
+		config, err := c.getExecutionQueueConfig()
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			return c.createWorkflowWithQueue(namespace, workflowTemplateVersionID, wf, opts, labels, config)
+		}
+	}
+
+	return c.submitWorkflow(namespace, workflowTemplateVersionID, wf, opts, labels)
+}
+
+// submitWorkflow creates the workflow in argo and the database
+func (c *Client) submitWorkflow(namespace string, workflowTemplateVersionID uint64, wf *wfv1.Workflow, opts *WorkflowExecutionOptions, labels types.JSONLabels) (createdWorkflow *WorkflowExecution, err error) {
 	createdArgoWorkflow, err := c.ArgoprojV1alpha1().Workflows(namespace).Create(wf)
 	if err != nil {
 		return nil, err
@@ -422,6 +444,8 @@ func (c *Client) createWorkflow(namespace string, workflowTemplateID uint64, wor
 		},
 		Parameters: opts.Parameters,
 		Labels:     labels,
+		Phase:      wfv1.NodePending,
+		Priority:   opts.Priority,
 	}
 
 	if err = createdWorkflow.GenerateUID(createdArgoWorkflow.Name); err != nil {
@@ -429,8 +453,8 @@ func (c *Client) createWorkflow(namespace string, workflowTemplateID uint64, wor
 	}
 
 	//Create an entry for workflow_executions statistic
-	//CURL code will hit the API endpoint that will update the db row
-	if err := c.createWorkflowExecutionDB(namespace, createdWorkflow); err != nil {
+	//The workflow execution controller and the CURL code update the db row
+	if err := c.createWorkflowExecutionDB(namespace, createdWorkflow, ""); err != nil {
 		return nil, err
 	}
 
@@ -522,9 +546,15 @@ func (c *Client) ValidateWorkflowExecution(namespace string, manifest []byte) (e
 // If workflow.Name is set, it is used instead of a generated name.
 // If there is a parameter named "workflow-execution-name" in workflow.Parameters, it is set as the name.
 func (c *Client) CreateWorkflowExecution(namespace string, workflow *WorkflowExecution, workflowTemplate *WorkflowTemplate) (*WorkflowExecution, error) {
+	if err := c.validateWorkflowExecutionParameters(workflow, workflowTemplate); err != nil {
+		return nil, err
+	}
+
 	opts := &WorkflowExecutionOptions{
 		Labels:     make(map[string]string),
 		Parameters: workflow.Parameters,
+		Queue:      !workflowTemplate.IsSystem,
+		Priority:   workflow.Priority,
 	}
 
 	if workflow.Name != "" {
@@ -568,11 +598,50 @@ func (c *Client) CreateWorkflowExecution(namespace string, workflow *WorkflowExe
 	workflow.Name = createdWorkflow.Name
 	workflow.CreatedAt = createdWorkflow.CreatedAt.UTC()
 	workflow.UID = createdWorkflow.UID
+	workflow.Phase = createdWorkflow.Phase
+	workflow.QueuedAt = createdWorkflow.QueuedAt
+	workflow.QueuePosition = createdWorkflow.QueuePosition
 	workflow.WorkflowTemplate = workflowTemplate
 
 	return workflow, nil
 }
 
+// validateWorkflowExecutionParameters checks the parameters of workflow against the parameters of workflowTemplate.
+// Invalid parameters are returned as an InvalidArgument error with a field violation for each of them.
+// System templates get parameters injected by the system, so they are not validated.
+func (c *Client) validateWorkflowExecutionParameters(workflow *WorkflowExecution, workflowTemplate *WorkflowTemplate) error {
+	if workflowTemplate.IsSystem {
+		return nil
+	}
+
+	templateParameters, err := ParseParametersFromManifest([]byte(workflowTemplate.Manifest))
+	if err != nil {
+		return util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	sysConfig, err := c.GetSystemConfig()
+	if err != nil {
+		return err
+	}
+
+	templateParameters, err = sysConfig.UpdateNodePoolOptions(templateParameters)
+	if err != nil {
+		return err
+	}
+
+	violations := ValidateParameterValues(templateParameters, workflow.Parameters, "workflow-execution-name")
+	if len(violations) == 0 {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"WorkflowTemplate": workflowTemplate.UID,
+		"Violations":       violations,
+	}).Error("Invalid workflow execution parameters.")
+
+	return util.NewFieldViolationsError("Invalid parameters.", violations)
+}
+
 func (c *Client) CloneWorkflowExecution(namespace, uid string) (*WorkflowExecution, error) {
 	// TODO do you need the and template here?
 	workflowExecution, err := c.getWorkflowExecutionAndTemplate(namespace, uid)
@@ -602,8 +671,14 @@ func (c *Client) CloneWorkflowExecution(namespace, uid string) (*WorkflowExecuti
 // * parameters, if any
 // * WorkflowTemplate.WorkflowTemplateVersionID
 //
+// Optional fields
+// * phase, Pending if empty
+// * priority
+//
+// queuedManifest is the workflow to submit when a queued execution is dispatched, it is empty for other executions.
+//
 // After success, the passed in WorkflowExecution will have it's ID set to the new db record.
-func (c *Client) createWorkflowExecutionDB(namespace string, workflowExecution *WorkflowExecution) (err error) {
+func (c *Client) createWorkflowExecutionDB(namespace string, workflowExecution *WorkflowExecution, queuedManifest string) (err error) {
 	parametersJSON, err := json.Marshal(workflowExecution.Parameters)
 	if err != nil {
 		return err
@@ -613,18 +688,30 @@ func (c *Client) createWorkflowExecutionDB(namespace string, workflowExecution *
 		return err
 	}
 
+	phase := workflowExecution.Phase
+	if phase == "" {
+		phase = wfv1.NodePending
+	}
+
+	fieldMap := sq.Eq{
+		"UID":                          workflowExecution.UID,
+		"workflow_template_version_id": workflowExecution.WorkflowTemplate.WorkflowTemplateVersionID,
+		"name":                         workflowExecution.Name,
+		"namespace":                    namespace,
+		"created_at":                   workflowExecution.CreatedAt.UTC(),
+		"phase":                        phase,
+		"parameters":                   string(parametersJSON),
+		"is_archived":                  false,
+		"labels":                       workflowExecution.Labels,
+		"priority":                     workflowExecution.Priority,
+	}
+	if queuedManifest != "" {
+		fieldMap["queued_manifest"] = queuedManifest
+		fieldMap["queued_at"] = workflowExecution.CreatedAt.UTC()
+	}
+
 	err = sb.Insert("workflow_executions").
-		SetMap(sq.Eq{
-			"UID":                          workflowExecution.UID,
-			"workflow_template_version_id": workflowExecution.WorkflowTemplate.WorkflowTemplateVersionID,
-			"name":                         workflowExecution.Name,
-			"namespace":                    namespace,
-			"created_at":                   workflowExecution.CreatedAt.UTC(),
-			"phase":                        wfv1.NodePending,
-			"parameters":                   string(parametersJSON),
-			"is_archived":                  false,
-			"labels":                       workflowExecution.Labels,
-		}).
+		SetMap(fieldMap).
 		Suffix("RETURNING id").
 		RunWith(c.DB).
 		QueryRow().
@@ -634,7 +721,8 @@ func (c *Client) createWorkflowExecutionDB(namespace string, workflowExecution *
 }
 
 func (c *Client) FinishWorkflowExecutionStatisticViaExitHandler(namespace, name string, workflowTemplateID int64, phase wfv1.NodePhase, startedAt time.Time) (err error) {
-	_, err = sb.Update("workflow_executions").
+	where := sq.Eq{"namespace": namespace, "name": name}
+	update := sb.Update("workflow_executions").
 		SetMap(sq.Eq{
 			"started_at":  startedAt.UTC(),
 			"name":        name,
@@ -642,11 +730,18 @@ func (c *Client) FinishWorkflowExecutionStatisticViaExitHandler(namespace, name
 			"finished_at": time.Now().UTC(),
 			"phase":       phase,
 		}).
-		Where(sq.Eq{"name": name}).
-		RunWith(c.DB).
-		Exec()
+		Where(where)
 
-	return err
+	before, updated, err := c.updateNotificationSource("workflow_executions", where, update)
+	if err != nil {
+		return err
+	}
+
+	if updated {
+		c.notifyWorkflowExecutionPhase(namespace, before, phase)
+	}
+
+	return nil
 }
 
 func (c *Client) CronStartWorkflowExecutionStatisticInsert(namespace, uid string, workflowTemplateID int64) (err error) {
@@ -718,7 +813,16 @@ func (c *Client) GetWorkflowExecution(namespace, uid string) (workflow *Workflow
 		return nil, err
 	}
 
-	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	var wf *wfv1.Workflow
+	if workflow.Phase == WorkflowExecutionQueued {
+		// Queued workflows are not in argo yet
+		wf, err = c.getQueuedWorkflow(namespace, uid)
+		if err == nil {
+			workflow.QueuePosition, err = c.getWorkflowExecutionQueuePosition(namespace, workflow)
+		}
+	} else {
+		wf, err = c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	}
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Namespace": namespace,
@@ -817,265 +921,146 @@ func (c *Client) CountWorkflowExecutions(namespace, workflowTemplateUID, workflo
 	return
 }
 
-func (c *Client) WatchWorkflowExecution(namespace, uid string) (<-chan *WorkflowExecution, error) {
-	_, err := c.GetWorkflowExecution(namespace, uid)
+// WatchWorkflowExecution streams the workflow execution each time it changes, until it finishes or is deleted, or stopCh is closed.
+// The current workflow execution is sent first, unless its version is resourceVersion, the last one the client has.
+func (c *Client) WatchWorkflowExecution(namespace, uid, resourceVersion string, stopCh <-chan struct{}) (<-chan *WorkflowExecution, error) {
+	workflows := c.ArgoprojV1alpha1().Workflows(namespace)
+	workflow, err := workflows.Get(uid, metav1.GetOptions{})
 	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+		}
 		log.WithFields(log.Fields{
 			"Namespace": namespace,
 			"UID":       uid,
 			"Error":     err.Error(),
-		}).Errorf("Workflow execution not found for namespace: %v, uid: %v).", namespace, uid)
-		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+		}).Error("Watch Workflow error.")
+		return nil, util.NewUserError(codes.Unknown, "Error with watching workflow.")
 	}
 
 	fieldSelector, _ := fields.ParseSelector(fmt.Sprintf("metadata.name=%s", uid))
-	watcher, err := c.ArgoprojV1alpha1().Workflows(namespace).Watch(metav1.ListOptions{
+	workflowWatch := newWorkflowWatch(workflows, metav1.ListOptions{
 		FieldSelector: fieldSelector.String(),
-	})
-	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"UID":       uid,
-			"Error":     err.Error(),
-		}).Error("Watch Workflow error.")
-		return nil, util.NewUserError(codes.Unknown, "Error with watching workflow.")
-	}
+	}, workflow.ResourceVersion, stopCh)
+	workflowWatch.current[workflow.Name] = workflow
+	workflowWatch.listed = true
 
 	workflowWatcher := make(chan *WorkflowExecution)
-	go func() {
-		var next watch.Event
-		done := false
-
-		for !done {
-			for next = range watcher.ResultChan() {
-				workflow, ok := next.Object.(*wfv1.Workflow)
-				if !ok {
-					done = true
-					break
-				}
-				if workflow == nil {
-					continue
-				}
+	// send returns false once the workflow is done, or an error occurred
+	send := func(workflow *wfv1.Workflow, deleted bool) bool {
+		if workflow.Name != uid {
+			return true
+		}
+		if deleted {
+			return false
+		}
 
-				manifest, err := json.Marshal(workflow)
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace": namespace,
-						"UID":       uid,
-						"Workflow":  workflow,
-						"Error":     err.Error(),
-					}).Error("Error with trying to JSON Marshal workflow.Status.")
-					done = true
-					break
-				}
+		manifest, err := json.Marshal(workflow)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Workflow":  workflow,
+				"Error":     err.Error(),
+			}).Error("Error with trying to JSON Marshal workflow.Status.")
+			return false
+		}
 
-				workflowWatcher <- &WorkflowExecution{
-					CreatedAt:  workflow.CreationTimestamp.UTC(),
-					StartedAt:  ptr.Time(workflow.Status.StartedAt.UTC()),
-					FinishedAt: ptr.Time(workflow.Status.FinishedAt.UTC()),
-					UID:        workflow.Name,
-					Name:       workflow.Name,
-					Manifest:   string(manifest),
-				}
+		workflowExecution := &WorkflowExecution{
+			CreatedAt:       workflow.CreationTimestamp.UTC(),
+			StartedAt:       ptr.Time(workflow.Status.StartedAt.UTC()),
+			FinishedAt:      ptr.Time(workflow.Status.FinishedAt.UTC()),
+			UID:             workflow.Name,
+			Name:            workflow.Name,
+			Manifest:        string(manifest),
+			ResourceVersion: workflow.ResourceVersion,
+		}
 
-				if !workflow.Status.FinishedAt.IsZero() {
-					done = true
-					break
-				}
-			}
+		select {
+		case workflowWatcher <- workflowExecution:
+		case <-stopCh:
+			return false
+		}
 
-			// We want to continue to watch the workflow until it is done, or an error occurred
-			// If it is not done, create a new watch and continue watching.
-			if !done {
-				workflow, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace": namespace,
-						"UID":       uid,
-						"Workflow":  workflow,
-						"Error":     err.Error(),
-					}).Error("Unable to get workflow.")
-
-					done = true
-					break
-				}
+		return workflow.Status.FinishedAt.IsZero()
+	}
 
-				if workflow.Status.Phase == wfv1.NodeRunning {
-					watcher, err = c.ArgoprojV1alpha1().Workflows(namespace).Watch(metav1.ListOptions{
-						FieldSelector: fieldSelector.String(),
-					})
-					if err != nil {
-						log.WithFields(log.Fields{
-							"Namespace": namespace,
-							"UID":       uid,
-							"Error":     err.Error(),
-						}).Error("Watch Workflow error.")
-						done = true
-						break
-					}
-				} else {
-					done = true
-					break
-				}
-			}
+	go func() {
+		defer close(workflowWatcher)
+
+		if workflow.ResourceVersion != resourceVersion && !send(workflow, false) {
+			return
+		}
+		// A finished workflow the client already has doesn't change anymore
+		if !workflow.Status.FinishedAt.IsZero() {
+			return
 		}
 
-		watcher.Stop()
-		close(workflowWatcher)
+		if err := workflowWatch.run(send); err != nil && err != errWatchStopped {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Error":     err.Error(),
+			}).Error("Watch Workflow error.")
+		}
 	}()
 
 	return workflowWatcher, nil
 }
 
-func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName string) (<-chan *LogEntry, error) {
-	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+// GetWorkflowExecutionLogs streams the log entries of the container that match opts, until the log ends or ctx is done.
+// The log of a running pod is followed, the log of a completed pod is read in full from the artifact repository.
+func (c *Client) GetWorkflowExecutionLogs(ctx context.Context, namespace, uid, podName, containerName string, opts *LogOptions) (<-chan *LogEntry, error) {
+	filter, err := newLogFilter(opts)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace":     namespace,
-			"UID":           uid,
-			"PodName":       podName,
-			"ContainerName": containerName,
-			"Error":         err.Error(),
-		}).Error("Workflow not found.")
-		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+		return nil, err
 	}
 
-	var (
-		stream    io.ReadCloser
-		s3Client  *s3.Client
-		gcsClient *gcs.Client
-		config    *NamespaceConfig
-		endOffset int
-	)
-
-	if wf.Status.Nodes[podName].Completed() {
-		config, err = c.GetNamespaceConfig(namespace)
-		if err != nil {
-			log.WithFields(log.Fields{
-				"Namespace":     namespace,
-				"UID":           uid,
-				"PodName":       podName,
-				"ContainerName": containerName,
-				"Error":         err.Error(),
-			}).Error("Can't get configuration.")
-			return nil, util.NewUserError(codes.NotFound, "Can't get configuration.")
-		}
-
-		switch {
-		case config.ArtifactRepository.S3 != nil:
-			{
-				s3Client, err = c.GetS3Client(namespace, config.ArtifactRepository.S3)
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't connect to S3 storage.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
-				}
-
-				opts := s3.GetObjectOptions{}
-				endOffset, err = strconv.Atoi(readEndOffset)
-				if err != nil {
-					return nil, util.NewUserError(codes.InvalidArgument, "Invalid range.")
-				}
-				err = opts.SetRange(0, int64(endOffset))
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't set range.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
-				}
-
-				key := config.ArtifactRepository.S3.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
-				stream, err = s3Client.GetObject(config.ArtifactRepository.S3.Bucket, key, opts)
-			}
-		case config.ArtifactRepository.GCS != nil:
-			{
-				gcsClient, err = c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't connect to GCS storage.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to GCS storage.")
-				}
-				key := config.ArtifactRepository.GCS.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
-				stream, err = gcsClient.GetObject(config.ArtifactRepository.GCS.Bucket, key)
-			}
-		}
-	} else {
-		stream, err = c.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
-			Container:  containerName,
-			Follow:     true,
-			Timestamps: true,
-		}).Stream()
+	node, err := c.getWorkflowPodNode(namespace, uid, podName)
+	if err != nil {
+		return nil, err
 	}
-	// TODO: Catch exact kubernetes error
-	//Todo: Can above todo be removed with the logging error?
+
+	stream, err := c.openContainerLog(namespace, uid, podName, containerName, node.Completed(), 0, true, opts)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace":     namespace,
-			"UID":           uid,
-			"PodName":       podName,
-			"ContainerName": containerName,
-			"Error":         err.Error(),
-		}).Error("Error with logs.")
-		return nil, util.NewUserError(codes.NotFound, "Log not found.")
+		return nil, err
 	}
 
 	logWatcher := make(chan *LogEntry)
 	go func() {
-		buffer := make([]byte, 4096)
-		reader := bufio.NewReader(stream)
-
-		newLine := true
-		for {
-			bytesRead, err := reader.Read(buffer)
-			if err != nil && err.Error() != "EOF" {
-				break
+		defer close(logWatcher)
+
+		// Closing the stream stops a read that is waiting for a running pod
+		readDone := make(chan struct{})
+		defer close(readDone)
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-readDone:
 			}
-			content := string(buffer[:bytesRead])
-
-			if newLine {
-				parts := strings.Split(content, " ")
-				if len(parts) == 0 {
-					logWatcher <- &LogEntry{Content: content}
-				} else {
-					timestamp, err := time.Parse(time.RFC3339, parts[0])
-					if err != nil {
-						logWatcher <- &LogEntry{Content: content}
-					} else {
-						logWatcher <- &LogEntry{
-							Timestamp: timestamp,
-							Content:   strings.Join(parts[1:], " "),
-						}
-					}
-				}
-			} else {
-				logWatcher <- &LogEntry{Content: content}
-			}
-
-			if err != nil && err.Error() == "EOF" {
-				break
+			stream.Close()
+		}()
+
+		err := readLogEntries(stream, filter, readTailLines(opts, node.Completed()), func(entry *LogEntry) bool {
+			select {
+			case logWatcher <- entry:
+				return true
+			case <-ctx.Done():
+				return false
 			}
-
-			newLine = strings.Contains(content, "\n")
+		})
+		if err != nil && ctx.Err() == nil {
+			log.WithFields(log.Fields{
+				"Namespace":     namespace,
+				"UID":           uid,
+				"PodName":       podName,
+				"ContainerName": containerName,
+				"Error":         err.Error(),
+			}).Error("Error reading logs.")
 		}
-
-		close(logWatcher)
 	}()
 
-	return logWatcher, err
+	return logWatcher, nil
 }
 
 func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (metrics []*Metric, err error) {
@@ -1084,6 +1069,11 @@ func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (me
 		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
 	}
 
+	return c.readWorkflowExecutionMetrics(namespace, uid, podName)
+}
+
+// readWorkflowExecutionMetrics reads the metrics the pod saved in the artifact repository
+func (c *Client) readWorkflowExecutionMetrics(namespace, uid, podName string) (metrics []*Metric, err error) {
 	var (
 		stream    io.ReadCloser
 		s3Client  *s3.Client
@@ -1156,6 +1146,11 @@ func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (me
 		}
 	}
 
+	if stream == nil {
+		return nil, util.NewUserError(codes.NotFound, "Metrics do not exist.")
+	}
+	defer stream.Close()
+
 	content, err := ioutil.ReadAll(stream)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -1183,17 +1178,35 @@ func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (me
 	return
 }
 
-func (c *Client) RetryWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, err error) {
+// RetryWorkflowExecution runs the failed nodes of a failed workflow execution again, or restarts it from a node.
+// See RetryWorkflowExecutionOptions.
+func (c *Client) RetryWorkflowExecution(namespace, uid string, opts *RetryWorkflowExecutionOptions) (workflow *WorkflowExecution, err error) {
+	if opts == nil {
+		opts = &RetryWorkflowExecutionOptions{}
+	}
+
 	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
 	if err != nil {
-		return
+		return nil, workflowTransitionError(namespace, uid, err)
 	}
 
-	wf, err = argoutil.RetryWorkflow(c, c.ArgoprojV1alpha1().Workflows(namespace), wf)
+	if opts.RestartNodeID != "" {
+		if err := c.restartWorkflow(wf, opts.RestartNodeID); err != nil {
+			return nil, err
+		}
+	} else {
+		// Terminated workflows have a shutdown strategy that would stop the retry
+		wf.Spec.Shutdown = ""
+		if _, err := argoutil.RetryWorkflow(c, c.ArgoprojV1alpha1().Workflows(namespace), wf); err != nil {
+			return nil, workflowTransitionError(namespace, uid, err)
+		}
+	}
 
-	workflow = typeWorkflow(wf)
+	if err := c.updateWorkflowExecutionPhase(namespace, uid, wfv1.NodeRunning); err != nil {
+		return nil, err
+	}
 
-	return
+	return c.GetWorkflowExecution(namespace, uid)
 }
 
 func (c *Client) ResubmitWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, err error) {
@@ -1217,27 +1230,38 @@ func (c *Client) ResubmitWorkflowExecution(namespace, uid string) (workflow *Wor
 	return
 }
 
+// ResumeWorkflowExecution resumes a suspended workflow execution and marks it as running in the database
 func (c *Client) ResumeWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, err error) {
 	err = argoutil.ResumeWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), uid, "")
 	if err != nil {
-		return
+		return nil, workflowTransitionError(namespace, uid, err)
 	}
 
-	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
-
-	workflow = typeWorkflow(wf)
+	if err := c.updateWorkflowExecutionPhase(namespace, uid, wfv1.NodeRunning); err != nil {
+		return nil, err
+	}
 
-	return
+	return c.GetWorkflowExecution(namespace, uid)
 }
 
+// SuspendWorkflowExecution suspends a running workflow execution and marks it as suspended in the database
 func (c *Client) SuspendWorkflowExecution(namespace, uid string) (err error) {
 	err = argoutil.SuspendWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), uid)
+	if err != nil {
+		return workflowTransitionError(namespace, uid, err)
+	}
 
-	return
+	return c.updateWorkflowExecutionPhase(namespace, uid, WorkflowExecutionSuspended)
 }
 
 // TerminateWorkflowExecution marks a workflows execution as terminated in DB and terminates the argo resource.
+// A queued workflow execution is removed from the queue.
 func (c *Client) TerminateWorkflowExecution(namespace, uid string) (err error) {
+	cancelled, err := c.cancelQueuedWorkflowExecution(namespace, uid)
+	if err != nil || cancelled {
+		return err
+	}
+
 	_, err = sb.Update("workflow_executions").
 		Set("phase", "Terminated").
 		Set("started_at", time.Time.UTC(time.Now())).
@@ -1318,6 +1342,18 @@ func (c *Client) GetArtifact(namespace, uid, key string) (data []byte, err error
 }
 
 func (c *Client) ListFiles(namespace, key string) (files []*File, err error) {
+	if len(key) > 0 {
+		if string(key[len(key)-1]) != "/" {
+			key += "/"
+		}
+	}
+
+	return c.listArtifactObjects(namespace, key, false)
+}
+
+// listArtifactObjects lists the objects under the prefix in the artifact repository of the namespace.
+// S3 objects in "subdirectories" of the prefix are only listed if recursive is true.
+func (c *Client) listArtifactObjects(namespace, prefix string, recursive bool) (files []*File, err error) {
 	config, err := c.GetNamespaceConfig(namespace)
 	if err != nil {
 		return
@@ -1325,11 +1361,6 @@ func (c *Client) ListFiles(namespace, key string) (files []*File, err error) {
 
 	files = make([]*File, 0)
 
-	if len(key) > 0 {
-		if string(key[len(key)-1]) != "/" {
-			key += "/"
-		}
-	}
 	switch {
 	case config.ArtifactRepository.S3 != nil:
 		{
@@ -1340,8 +1371,11 @@ func (c *Client) ListFiles(namespace, key string) (files []*File, err error) {
 
 			doneCh := make(chan struct{})
 			defer close(doneCh)
-			for objInfo := range s3Client.ListObjects(config.ArtifactRepository.S3.Bucket, key, false, doneCh) {
-				if objInfo.Key == key {
+			for objInfo := range s3Client.ListObjects(config.ArtifactRepository.S3.Bucket, prefix, recursive, doneCh) {
+				if objInfo.Err != nil {
+					return nil, objInfo.Err
+				}
+				if objInfo.Key == prefix {
 					continue
 				}
 
@@ -1355,6 +1389,7 @@ func (c *Client) ListFiles(namespace, key string) (files []*File, err error) {
 					LastModified: objInfo.LastModified,
 					ContentType:  objInfo.ContentType,
 					Directory:    isDirectory,
+					ETag:         objInfo.ETag,
 				}
 				files = append(files, newFile)
 			}
@@ -1368,7 +1403,7 @@ func (c *Client) ListFiles(namespace, key string) (files []*File, err error) {
 			}
 			q := &storage.Query{
 				Delimiter: "",
-				Prefix:    key,
+				Prefix:    prefix,
 				Versions:  false,
 			}
 			bucketFiles := gcsClient.Bucket(config.ArtifactRepository.GCS.Bucket).Objects(ctx, q)
@@ -1381,7 +1416,7 @@ func (c *Client) ListFiles(namespace, key string) (files []*File, err error) {
 					}
 					return nil, err
 				}
-				if file.Name == key {
+				if file.Name == prefix {
 					continue
 				}
 				isDirectory := (file.Etag == "" || strings.HasSuffix(file.Name, "/")) && file.Size == 0
@@ -1394,6 +1429,7 @@ func (c *Client) ListFiles(namespace, key string) (files []*File, err error) {
 					LastModified: file.Updated,
 					ContentType:  file.ContentType,
 					Directory:    isDirectory,
+					ETag:         file.Etag,
 				}
 				files = append(files, newFile)
 			}
@@ -1894,17 +1930,22 @@ func (c *Client) UpdateWorkflowExecutionStatus(namespace, uid string, status *Wo
 		fieldMap["started_at"] = time.Now().UTC()
 		break
 	}
-	_, err = sb.Update("workflow_executions").
+	where := sq.Eq{
+		"namespace": namespace,
+		"uid":       uid,
+	}
+	update := sb.Update("workflow_executions").
 		SetMap(fieldMap).
-		Where(sq.Eq{
-			"namespace": namespace,
-			"uid":       uid,
-		}).
-		RunWith(c.DB).
-		Exec()
+		Where(where)
+
+	before, updated, err := c.updateNotificationSource("workflow_executions", where, update)
 	if err != nil {
 		return util.NewUserError(codes.NotFound, "Workflow execution not found.")
 	}
 
+	if updated {
+		c.notifyWorkflowExecutionPhase(namespace, before, status.Phase)
+	}
+
 	return
 }
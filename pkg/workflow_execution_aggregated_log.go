@@ -0,0 +1,295 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// aggregatedLogContainerName is the container that runs the template of each step
+	aggregatedLogContainerName = "main"
+	// aggregatedLogPollInterval is how often the workflow is checked for new pods
+	aggregatedLogPollInterval = 2 * time.Second
+	// logReorderWindow is how long entries wait for earlier entries from other pods before they are sent
+	logReorderWindow = 2 * time.Second
+	// logFlushInterval is how often entries held back by logReorderWindow are checked
+	logFlushInterval = 250 * time.Millisecond
+)
+
+type mergedLogEntry struct {
+	entry *LogEntry
+	// sortTime is the timestamp of the entry, or of the entry before it if it has none
+	sortTime time.Time
+}
+
+type logMergeSource struct {
+	name          string
+	entries       []mergedLogEntry
+	lastTimestamp time.Time
+	lastReceived  time.Time
+	done          bool
+}
+
+// logMerger interleaves the entries of several logs by timestamp.
+// Each log is already in order, so an entry is sent once every other log has a later entry, has finished,
+// or has not received anything for the reorder window.
+type logMerger struct {
+	sources []*logMergeSource
+	window  time.Duration
+	now     func() time.Time
+}
+
+func newLogMerger(window time.Duration) *logMerger {
+	return &logMerger{
+		window: window,
+		now:    time.Now,
+	}
+}
+
+func (m *logMerger) source(name string) *logMergeSource {
+	for _, source := range m.sources {
+		if source.name == name {
+			return source
+		}
+	}
+
+	return nil
+}
+
+// add starts tracking a log
+func (m *logMerger) add(name string) {
+	m.sources = append(m.sources, &logMergeSource{
+		name:         name,
+		lastReceived: m.now(),
+	})
+}
+
+// push adds an entry of the log
+func (m *logMerger) push(name string, entry *LogEntry) {
+	source := m.source(name)
+	if source == nil {
+		return
+	}
+
+	if !entry.Timestamp.IsZero() {
+		source.lastTimestamp = entry.Timestamp
+	}
+	source.lastReceived = m.now()
+	source.entries = append(source.entries, mergedLogEntry{
+		entry:    entry,
+		sortTime: source.lastTimestamp,
+	})
+}
+
+// finish marks the log as complete
+func (m *logMerger) finish(name string) {
+	if source := m.source(name); source != nil {
+		source.done = true
+	}
+}
+
+// pop returns the next entry in timestamp order, or nil if there is none or it can't be known yet
+func (m *logMerger) pop() *LogEntry {
+	var next *logMergeSource
+	for _, source := range m.sources {
+		if len(source.entries) == 0 {
+			continue
+		}
+		if next == nil || source.entries[0].sortTime.Before(next.entries[0].sortTime) {
+			next = source
+		}
+	}
+	if next == nil {
+		return nil
+	}
+
+	now := m.now()
+	for _, source := range m.sources {
+		if source == next || source.done || len(source.entries) != 0 {
+			continue
+		}
+		// The source may still send an earlier entry
+		if now.Sub(source.lastReceived) < m.window {
+			return nil
+		}
+	}
+
+	entry := next.entries[0].entry
+	next.entries = next.entries[1:]
+
+	return entry
+}
+
+// drained returns true if every log is complete and all entries were returned
+func (m *logMerger) drained() bool {
+	for _, source := range m.sources {
+		if !source.done || len(source.entries) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// aggregatedLogEvent is sent by the readers of each pod's log
+type aggregatedLogEvent struct {
+	podName string
+	entry   *LogEntry
+	err     error
+	done    bool
+}
+
+// GetWorkflowExecutionAggregatedLogs streams the logs of every pod of the workflow execution, interleaved by timestamp.
+// Pods that start while the workflow runs are followed as well. The stream ends when the workflow has finished
+// and every log was sent, or when ctx is done.
+// opts apply to each pod's log separately, e.g. TailLines returns the last lines of each pod.
+func (c *Client) GetWorkflowExecutionAggregatedLogs(ctx context.Context, namespace, uid string, opts *LogOptions) (<-chan *LogEntry, error) {
+	// Validate the options and the workflow before streaming, so errors can be returned to the caller
+	if _, err := newLogFilter(opts); err != nil {
+		return nil, err
+	}
+
+	graph, err := c.GetWorkflowExecutionGraph(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	events := make(chan aggregatedLogEvent)
+	logWatcher := make(chan *LogEntry)
+
+	go func() {
+		defer close(logWatcher)
+		// Stops the readers of the pods
+		defer cancel()
+
+		merger := newLogMerger(logReorderWindow)
+		followed := make(map[string]bool)
+		finished := false
+
+		followNodes := func(graph *WorkflowExecutionGraph) {
+			for _, node := range graph.Nodes {
+				if node.PodName == "" || followed[node.PodName] || node.Phase == "" || node.Phase == wfv1.NodePending {
+					continue
+				}
+
+				followed[node.PodName] = true
+				merger.add(node.PodName)
+				go c.readAggregatedLog(ctx, namespace, uid, node, opts, events)
+			}
+
+			finished = graph.Phase.Completed()
+		}
+		followNodes(graph)
+
+		pollTicker := time.NewTicker(aggregatedLogPollInterval)
+		defer pollTicker.Stop()
+		flushTicker := time.NewTicker(logFlushInterval)
+		defer flushTicker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				if event.err != nil {
+					log.WithFields(log.Fields{
+						"Namespace": namespace,
+						"UID":       uid,
+						"PodName":   event.podName,
+						"Error":     event.err.Error(),
+					}).Error("Error reading pod logs.")
+				}
+				if event.entry != nil {
+					merger.push(event.podName, event.entry)
+				}
+				if event.done {
+					merger.finish(event.podName)
+				}
+			case <-pollTicker.C:
+				if finished {
+					break
+				}
+
+				graph, err := c.GetWorkflowExecutionGraph(namespace, uid)
+				if err != nil {
+					log.WithFields(log.Fields{
+						"Namespace": namespace,
+						"UID":       uid,
+						"Error":     err.Error(),
+					}).Error("Unable to get workflow graph.")
+					finished = true
+					break
+				}
+				followNodes(graph)
+			case <-flushTicker.C:
+			}
+
+			for entry := merger.pop(); entry != nil; entry = merger.pop() {
+				select {
+				case logWatcher <- entry:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if finished && merger.drained() {
+				return
+			}
+		}
+	}()
+
+	return logWatcher, nil
+}
+
+// readAggregatedLog sends the entries of the node's log to events, followed by a done event
+func (c *Client) readAggregatedLog(ctx context.Context, namespace, uid string, node *WorkflowExecutionNode, opts *LogOptions, events chan<- aggregatedLogEvent) {
+	send := func(event aggregatedLogEvent) bool {
+		event.podName = node.PodName
+		select {
+		case events <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	filter, err := newLogFilter(opts)
+	if err != nil {
+		send(aggregatedLogEvent{err: err, done: true})
+		return
+	}
+
+	stream, err := c.openContainerLog(namespace, uid, node.PodName, aggregatedLogContainerName, node.Phase.Completed(), 0, true, opts)
+	if err != nil {
+		send(aggregatedLogEvent{err: err, done: true})
+		return
+	}
+
+	// Closing the stream stops a read that is waiting for a running pod
+	readDone := make(chan struct{})
+	defer close(readDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-readDone:
+		}
+		stream.Close()
+	}()
+
+	err = readLogEntries(stream, filter, readTailLines(opts, node.Phase.Completed()), func(entry *LogEntry) bool {
+		entry.NodeName = node.DisplayName
+		entry.PodName = node.PodName
+		entry.ContainerName = aggregatedLogContainerName
+
+		return send(aggregatedLogEvent{entry: entry})
+	})
+	if ctx.Err() != nil {
+		return
+	}
+
+	send(aggregatedLogEvent{err: err, done: true})
+}
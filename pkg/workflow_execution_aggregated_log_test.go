@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogMerger(t *testing.T) {
+	now := time.Date(2020, 10, 1, 10, 0, 0, 0, time.UTC)
+	merger := newLogMerger(2 * time.Second)
+	merger.now = func() time.Time {
+		return now
+	}
+
+	at := func(seconds int, content string) *LogEntry {
+		return &LogEntry{Timestamp: now.Add(time.Duration(seconds) * time.Second), Content: content}
+	}
+
+	merger.add("a")
+	merger.add("b")
+
+	merger.push("a", at(1, "a1"))
+	merger.push("a", &LogEntry{Content: "a1 continued"})
+	merger.push("a", at(3, "a3"))
+	// b may still send an earlier entry
+	assert.Nil(t, merger.pop())
+
+	merger.push("b", at(2, "b2"))
+	assert.Equal(t, "a1", merger.pop().Content)
+	assert.Equal(t, "a1 continued", merger.pop().Content)
+	assert.Equal(t, "b2", merger.pop().Content)
+	assert.Nil(t, merger.pop())
+
+	// b is idle for the reorder window
+	now = now.Add(3 * time.Second)
+	assert.Equal(t, "a3", merger.pop().Content)
+	assert.False(t, merger.drained())
+
+	merger.finish("a")
+	merger.finish("b")
+	assert.True(t, merger.drained())
+}
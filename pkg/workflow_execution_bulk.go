@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// maxBulkWorkflowExecutions is the most workflow executions a bulk operation can select
+	maxBulkWorkflowExecutions = 1000
+	// bulkOperationConcurrency is the number of workflow executions a bulk operation updates at the same time
+	bulkOperationConcurrency = 10
+)
+
+// selectWorkflowExecutions returns the workflow executions matched by the selector.
+// If more than maxBulkWorkflowExecutions match, an error is returned instead so the caller can narrow the selector.
+func (c *Client) selectWorkflowExecutions(namespace string, selector *WorkflowExecutionSelector) ([]*WorkflowExecution, error) {
+	resourceRequest := &request.Request{
+		Filter: selector.Filter,
+	}
+
+	count, err := c.CountWorkflowExecutions(namespace, selector.WorkflowTemplateUID, selector.WorkflowTemplateVersion, selector.IncludeSystem, resourceRequest)
+	if err != nil {
+		return nil, err
+	}
+	if count > maxBulkWorkflowExecutions {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("%v workflow executions match, at most %v can be selected at once.", count, maxBulkWorkflowExecutions))
+	}
+
+	return c.ListWorkflowExecutions(namespace, selector.WorkflowTemplateUID, selector.WorkflowTemplateVersion, selector.IncludeSystem, resourceRequest)
+}
+
+// runBulkOperation calls operation for each workflow execution, at most bulkOperationConcurrency at a time.
+// skip returns a reason to leave a workflow execution unchanged, or an empty string.
+// With dryRun nothing is changed, the results show which workflow executions would be.
+func runBulkOperation(namespace string, workflowExecutions []*WorkflowExecution, dryRun bool, skip func(wf *WorkflowExecution) string, operation func(wf *WorkflowExecution) error) []*BulkWorkflowExecutionResult {
+	results := make([]*BulkWorkflowExecutionResult, len(workflowExecutions))
+
+	semaphore := make(chan struct{}, bulkOperationConcurrency)
+	wg := sync.WaitGroup{}
+	for i, wf := range workflowExecutions {
+		result := &BulkWorkflowExecutionResult{
+			UID:   wf.UID,
+			Name:  wf.Name,
+			Phase: wf.Phase,
+		}
+		results[i] = result
+
+		if reason := skip(wf); reason != "" {
+			result.Status = BulkItemSkipped
+			result.Message = reason
+			continue
+		}
+
+		if dryRun {
+			result.Status = BulkItemSelected
+			continue
+		}
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(wf *WorkflowExecution) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := operation(wf); err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": namespace,
+					"UID":       wf.UID,
+					"Error":     err.Error(),
+				}).Error("Bulk operation failed.")
+
+				result.Status = BulkItemFailed
+				result.Message = err.Error()
+				return
+			}
+
+			result.Status = BulkItemSucceeded
+		}(wf)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// BulkTerminateWorkflowExecutions terminates the running workflow executions matched by the selector.
+// Workflow executions that have finished are skipped.
+func (c *Client) BulkTerminateWorkflowExecutions(namespace string, selector *WorkflowExecutionSelector, dryRun bool) ([]*BulkWorkflowExecutionResult, error) {
+	workflowExecutions, err := c.selectWorkflowExecutions(namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := func(wf *WorkflowExecution) string {
+		if wf.FinishedAt != nil && !wf.FinishedAt.IsZero() {
+			return "Workflow execution has finished."
+		}
+		return ""
+	}
+
+	return runBulkOperation(namespace, workflowExecutions, dryRun, skip, func(wf *WorkflowExecution) error {
+		return c.TerminateWorkflowExecution(namespace, wf.UID)
+	}), nil
+}
+
+// BulkArchiveWorkflowExecutions archives the workflow executions matched by the selector
+func (c *Client) BulkArchiveWorkflowExecutions(namespace string, selector *WorkflowExecutionSelector, dryRun bool) ([]*BulkWorkflowExecutionResult, error) {
+	workflowExecutions, err := c.selectWorkflowExecutions(namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := func(wf *WorkflowExecution) string {
+		return ""
+	}
+
+	return runBulkOperation(namespace, workflowExecutions, dryRun, skip, func(wf *WorkflowExecution) error {
+		return c.ArchiveWorkflowExecution(namespace, wf.UID)
+	}), nil
+}
+
+// BulkLabelWorkflowExecutions adds the labels to the workflow executions matched by the selector.
+// Existing labels with the same keys are replaced.
+func (c *Client) BulkLabelWorkflowExecutions(namespace string, selector *WorkflowExecutionSelector, keyValues map[string]string, dryRun bool) ([]*BulkWorkflowExecutionResult, error) {
+	if len(keyValues) == 0 {
+		return nil, util.NewUserError(codes.InvalidArgument, "At least one label is required.")
+	}
+
+	workflowExecutions, err := c.selectWorkflowExecutions(namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := func(wf *WorkflowExecution) string {
+		return ""
+	}
+
+	return runBulkOperation(namespace, workflowExecutions, dryRun, skip, func(wf *WorkflowExecution) error {
+		labels := make(map[string]string)
+		for key, value := range wf.Labels {
+			labels[key] = value
+		}
+		for key, value := range keyValues {
+			labels[key] = value
+		}
+
+		err := c.ReplaceLabels(namespace, TypeWorkflowExecution, wf.UID, labels)
+		// The labels are stored in the database, the argo workflow may have been garbage collected
+		if errors.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}), nil
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBulkWorkflowExecutions(count int) []*WorkflowExecution {
+	workflowExecutions := make([]*WorkflowExecution, count)
+	for i := range workflowExecutions {
+		uid := fmt.Sprintf("test-%v", i)
+		workflowExecutions[i] = &WorkflowExecution{UID: uid, Name: uid}
+	}
+
+	return workflowExecutions
+}
+
+func TestRunBulkOperation(t *testing.T) {
+	skip := func(wf *WorkflowExecution) string {
+		if wf.UID == "test-0" {
+			return "skipped"
+		}
+		return ""
+	}
+
+	t.Run("Dry Run", func(t *testing.T) {
+		called := false
+		results := runBulkOperation("onepanel", newTestBulkWorkflowExecutions(3), true, skip, func(wf *WorkflowExecution) error {
+			called = true
+			return nil
+		})
+
+		assert.False(t, called)
+		assert.Equal(t, BulkItemSkipped, results[0].Status)
+		assert.Equal(t, "skipped", results[0].Message)
+		assert.Equal(t, BulkItemSelected, results[1].Status)
+		assert.Equal(t, BulkItemSelected, results[2].Status)
+	})
+
+	t.Run("Results", func(t *testing.T) {
+		results := runBulkOperation("onepanel", newTestBulkWorkflowExecutions(3), false, skip, func(wf *WorkflowExecution) error {
+			if wf.UID == "test-2" {
+				return fmt.Errorf("failed")
+			}
+			return nil
+		})
+
+		assert.Equal(t, BulkItemSkipped, results[0].Status)
+		assert.Equal(t, BulkItemSucceeded, results[1].Status)
+		assert.Equal(t, "test-1", results[1].UID)
+		assert.Equal(t, BulkItemFailed, results[2].Status)
+		assert.Equal(t, "failed", results[2].Message)
+	})
+
+	t.Run("Bounded Concurrency", func(t *testing.T) {
+		mu := sync.Mutex{}
+		running := 0
+		maxRunning := 0
+		block := make(chan struct{})
+
+		go func() {
+			for i := 0; i < 50; i++ {
+				block <- struct{}{}
+			}
+		}()
+
+		runBulkOperation("onepanel", newTestBulkWorkflowExecutions(50), false, func(wf *WorkflowExecution) string { return "" }, func(wf *WorkflowExecution) error {
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+
+			<-block
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			return nil
+		})
+
+		assert.LessOrEqual(t, maxRunning, bulkOperationConcurrency)
+	})
+}
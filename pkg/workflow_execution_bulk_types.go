@@ -0,0 +1,32 @@
+package v1
+
+import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// Statuses of the items of a bulk operation
+const (
+	BulkItemSelected  = "Selected"
+	BulkItemSucceeded = "Succeeded"
+	BulkItemFailed    = "Failed"
+	BulkItemSkipped   = "Skipped"
+)
+
+// WorkflowExecutionSelector selects the workflow executions of a bulk operation.
+// It has the same criteria as ListWorkflowExecutions.
+type WorkflowExecutionSelector struct {
+	WorkflowTemplateUID     string
+	WorkflowTemplateVersion string
+	IncludeSystem           bool
+	Filter                  WorkflowExecutionFilter
+}
+
+// BulkWorkflowExecutionResult is the outcome of a bulk operation for one workflow execution.
+// Status is BulkItemSelected for dry runs. Message explains why an item failed or was skipped.
+type BulkWorkflowExecutionResult struct {
+	UID     string
+	Name    string
+	Phase   wfv1.NodePhase
+	Status  string
+	Message string
+}
@@ -0,0 +1,303 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	minComparedWorkflowExecutions = 2
+	maxComparedWorkflowExecutions = 10
+)
+
+// comparisonRows collects rows by key, in the order they are first seen
+type comparisonRows struct {
+	count int
+	rows  []*ComparisonRow
+	index map[string]*ComparisonRow
+}
+
+func newComparisonRows(count int) *comparisonRows {
+	return &comparisonRows{
+		count: count,
+		rows:  make([]*ComparisonRow, 0),
+		index: make(map[string]*ComparisonRow),
+	}
+}
+
+// set sets the value of the execution at position in the row of the step and name
+func (r *comparisonRows) set(step, name string, position int, value string) {
+	key := step + "\n" + name
+	row, ok := r.index[key]
+	if !ok {
+		row = &ComparisonRow{
+			Step:   step,
+			Name:   name,
+			Values: make([]*string, r.count),
+		}
+		r.index[key] = row
+		r.rows = append(r.rows, row)
+	}
+
+	row.Values[position] = &value
+}
+
+// result marks the rows whose values are not all the same and returns them
+func (r *comparisonRows) result() []*ComparisonRow {
+	for _, row := range r.rows {
+		for _, value := range row.Values[1:] {
+			if (value == nil) != (row.Values[0] == nil) || (value != nil && *value != *row.Values[0]) {
+				row.Different = true
+				break
+			}
+		}
+	}
+
+	return r.rows
+}
+
+// elapsed returns the time from start to end, or to now if end is nil
+func elapsed(start, end *time.Time, now time.Time) time.Duration {
+	if start == nil {
+		return 0
+	}
+	if end == nil {
+		return now.Sub(*start)
+	}
+
+	return end.Sub(*start)
+}
+
+// artifactNames returns the names of the artifacts, in order, to compare steps
+func artifactNames(artifacts []*WorkflowExecutionNodeArtifact) string {
+	names := make([]string, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		names = append(names, artifact.Name)
+	}
+
+	return strings.Join(names, "\n")
+}
+
+// newComparedStepValue returns how the pod node ran
+func newComparedStepValue(node *WorkflowExecutionNode, now time.Time) *ComparedStepValue {
+	value := &ComparedStepValue{
+		Phase:      node.Phase,
+		StartedAt:  node.StartedAt,
+		FinishedAt: node.FinishedAt,
+		Duration:   elapsed(node.StartedAt, node.FinishedAt, now),
+		Artifacts:  make([]*WorkflowExecutionNodeArtifact, 0),
+	}
+
+	if node.Outputs != nil {
+		value.Artifacts = node.Outputs.Artifacts
+	}
+
+	return value
+}
+
+// compareWorkflowExecutions lines up the parameters, metrics and steps of the inputs
+func compareWorkflowExecutions(inputs []*comparisonInput, now time.Time) *WorkflowExecutionComparison {
+	count := len(inputs)
+	comparison := &WorkflowExecutionComparison{
+		Executions: make([]*ComparedWorkflowExecution, 0, count),
+		Steps:      make([]*ComparedStep, 0),
+	}
+	parameters := newComparisonRows(count)
+	metrics := newComparisonRows(count)
+	steps := make(map[string]*ComparedStep)
+
+	for i, input := range inputs {
+		wf := input.workflow
+		execution := &ComparedWorkflowExecution{
+			UID:                       wf.UID,
+			Name:                      wf.Name,
+			Phase:                     wf.Phase,
+			WorkflowTemplateUID:       wf.WorkflowTemplate.UID,
+			WorkflowTemplateName:      wf.WorkflowTemplate.Name,
+			WorkflowTemplateVersion:   wf.WorkflowTemplate.Version,
+			WorkflowTemplateVersionID: wf.WorkflowTemplate.WorkflowTemplateVersionID,
+			StartedAt:                 wf.StartedAt,
+			FinishedAt:                wf.FinishedAt,
+		}
+		execution.Duration = elapsed(execution.StartedAt, execution.FinishedAt, now)
+		comparison.Executions = append(comparison.Executions, execution)
+
+		for _, param := range input.parameters {
+			value := ""
+			if param.Value != nil {
+				value = *param.Value
+			}
+			parameters.set("", param.Name, i, value)
+		}
+
+		if input.graph == nil {
+			continue
+		}
+
+		for _, node := range input.graph.Nodes {
+			if node.Type != wfv1.NodeTypePod {
+				continue
+			}
+
+			step, ok := steps[node.DisplayName]
+			if !ok {
+				step = &ComparedStep{
+					Name:   node.DisplayName,
+					Values: make([]*ComparedStepValue, count),
+				}
+				steps[node.DisplayName] = step
+				comparison.Steps = append(comparison.Steps, step)
+			}
+			// Steps are matched by name, the first node with the name is the step
+			if step.Values[i] != nil {
+				continue
+			}
+			step.Values[i] = newComparedStepValue(node, now)
+
+			for _, metric := range input.metrics[node.PodName] {
+				metrics.set(node.DisplayName, metric.Name, i, strconv.FormatFloat(metric.Value, 'f', -1, 64))
+			}
+		}
+	}
+
+	for _, step := range comparison.Steps {
+		first := step.Values[0]
+		for _, value := range step.Values[1:] {
+			if (value == nil) != (first == nil) ||
+				(value != nil && (value.Phase != first.Phase || artifactNames(value.Artifacts) != artifactNames(first.Artifacts))) {
+				step.Different = true
+				break
+			}
+		}
+	}
+
+	comparison.Parameters = parameters.result()
+	comparison.Metrics = metrics.result()
+
+	return comparison
+}
+
+// getComparedWorkflowExecution returns the workflow execution with the template version it ran,
+// including parameters the caller did not set, which have the default value of that version
+func (c *Client) getComparedWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, parameters []Parameter, err error) {
+	query := sb.Select(getWorkflowExecutionColumns("we")...).
+		Columns(getWorkflowTemplateColumns("wt", "workflow_template")...).
+		Columns(`wtv.manifest "workflow_template.manifest"`, `wtv.version "workflow_template.version"`, `wtv.id "workflow_template.workflow_template_version_id"`).
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON wtv.id = we.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.uid":         uid,
+			"we.is_archived": false,
+		})
+
+	workflow = &WorkflowExecution{}
+	if err = c.DB.Getx(workflow, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Workflow execution '%v' not found.", uid))
+		}
+		return nil, nil, err
+	}
+
+	workflow.Parameters = make([]Parameter, 0)
+	if len(workflow.ParametersBytes) > 0 {
+		if err = json.Unmarshal(workflow.ParametersBytes, &workflow.Parameters); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	templateParameters, err := ParseParametersFromManifest([]byte(workflow.WorkflowTemplate.Manifest))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to parse workflow template parameters.")
+		templateParameters = make([]Parameter, 0)
+	}
+
+	values := MapParametersByName(workflow.Parameters)
+	for _, param := range templateParameters {
+		if value, ok := values[param.Name]; ok {
+			param.Value = value.Value
+			delete(values, param.Name)
+		}
+		parameters = append(parameters, param)
+	}
+	// Parameters that are not in the template version, such as workflow-execution-name
+	for _, param := range workflow.Parameters {
+		if _, ok := values[param.Name]; ok {
+			parameters = append(parameters, param)
+		}
+	}
+
+	return
+}
+
+// CompareWorkflowExecutions returns the parameters, metrics, steps and outputs of the workflow executions side by side.
+// The executions may be of different versions of a template, or of different templates.
+func (c *Client) CompareWorkflowExecutions(namespace string, uids []string) (*WorkflowExecutionComparison, error) {
+	if len(uids) < minComparedWorkflowExecutions || len(uids) > maxComparedWorkflowExecutions {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Between %v and %v workflow executions can be compared.", minComparedWorkflowExecutions, maxComparedWorkflowExecutions))
+	}
+
+	seen := make(map[string]bool)
+	inputs := make([]*comparisonInput, 0, len(uids))
+	for _, uid := range uids {
+		if seen[uid] {
+			return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Workflow execution '%v' is compared more than once.", uid))
+		}
+		seen[uid] = true
+
+		workflow, parameters, err := c.getComparedWorkflowExecution(namespace, uid)
+		if err != nil {
+			return nil, err
+		}
+
+		input := &comparisonInput{
+			workflow:   workflow,
+			parameters: parameters,
+			metrics:    make(map[string][]*Metric),
+		}
+
+		// Executions whose workflow is gone without a stored manifest are compared without their steps and outputs
+		if workflow.Phase != WorkflowExecutionQueued {
+			input.graph, err = c.GetWorkflowExecutionGraph(namespace, uid)
+			if userErr, ok := err.(*util.UserError); ok && userErr.Code == codes.NotFound {
+				input.graph, err = nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if input.graph != nil {
+			for _, node := range input.graph.Nodes {
+				if node.PodName == "" || !node.Phase.Completed() {
+					continue
+				}
+
+				// Steps that don't report metrics have none
+				metrics, err := c.readWorkflowExecutionMetrics(namespace, uid, node.PodName)
+				if err == nil {
+					input.metrics[node.PodName] = metrics
+				}
+			}
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return compareWorkflowExecutions(inputs, time.Now().UTC()), nil
+}
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareWorkflowExecutions(t *testing.T) {
+	now := time.Date(2020, 10, 30, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) *time.Time {
+		result := now.Add(-time.Duration(minutes) * time.Minute)
+		return &result
+	}
+	pod := func(name string, phase wfv1.NodePhase, started, finished *time.Time, artifacts ...string) *WorkflowExecutionNode {
+		node := &WorkflowExecutionNode{
+			DisplayName: name,
+			Type:        wfv1.NodeTypePod,
+			PodName:     "pod-" + name,
+			Phase:       phase,
+			StartedAt:   started,
+			FinishedAt:  finished,
+			Outputs:     &WorkflowExecutionNodeIO{},
+		}
+		for _, artifact := range artifacts {
+			node.Outputs.Artifacts = append(node.Outputs.Artifacts, &WorkflowExecutionNodeArtifact{Name: artifact})
+		}
+		return node
+	}
+
+	inputs := []*comparisonInput{
+		{
+			workflow: &WorkflowExecution{
+				UID:              "first",
+				Phase:            wfv1.NodeSucceeded,
+				StartedAt:        at(60),
+				FinishedAt:       at(30),
+				WorkflowTemplate: &WorkflowTemplate{UID: "train", Version: 1, WorkflowTemplateVersionID: 10},
+			},
+			parameters: []Parameter{
+				{Name: "epochs", Value: ptr.String("10")},
+				{Name: "dataset", Value: ptr.String("a")},
+			},
+			graph: &WorkflowExecutionGraph{Nodes: []*WorkflowExecutionNode{
+				{DisplayName: "first", Type: wfv1.NodeTypeSteps},
+				pod("prepare", wfv1.NodeSucceeded, at(60), at(50)),
+				pod("train", wfv1.NodeSucceeded, at(50), at(30), "model"),
+			}},
+			metrics: map[string][]*Metric{
+				"pod-train": {{Name: "accuracy", Value: 0.9}, {Name: "loss", Value: 0.1}},
+			},
+		},
+		{
+			workflow: &WorkflowExecution{
+				UID:              "second",
+				Phase:            wfv1.NodeRunning,
+				StartedAt:        at(20),
+				WorkflowTemplate: &WorkflowTemplate{UID: "train", Version: 2, WorkflowTemplateVersionID: 11},
+			},
+			parameters: []Parameter{
+				{Name: "epochs", Value: ptr.String("20")},
+				{Name: "dataset", Value: ptr.String("a")},
+				{Name: "learning-rate", Value: ptr.String("0.01")},
+			},
+			graph: &WorkflowExecutionGraph{Nodes: []*WorkflowExecutionNode{
+				pod("prepare", wfv1.NodeSucceeded, at(20), at(10)),
+				pod("train", wfv1.NodeRunning, at(10), nil),
+			}},
+			metrics: map[string][]*Metric{},
+		},
+	}
+
+	comparison := compareWorkflowExecutions(inputs, now)
+
+	assert.Len(t, comparison.Executions, 2)
+	assert.Equal(t, int64(2), comparison.Executions[1].WorkflowTemplateVersion)
+	assert.Equal(t, uint64(11), comparison.Executions[1].WorkflowTemplateVersionID)
+	assert.Equal(t, 30*time.Minute, comparison.Executions[0].Duration)
+	// Executions that have not finished are measured until now
+	assert.Equal(t, 20*time.Minute, comparison.Executions[1].Duration)
+
+	// Parameters are aligned, a parameter that is missing in a version has a nil value
+	assert.Len(t, comparison.Parameters, 3)
+	assert.Equal(t, "epochs", comparison.Parameters[0].Name)
+	assert.True(t, comparison.Parameters[0].Different)
+	assert.Equal(t, "dataset", comparison.Parameters[1].Name)
+	assert.False(t, comparison.Parameters[1].Different)
+	assert.Equal(t, "learning-rate", comparison.Parameters[2].Name)
+	assert.Nil(t, comparison.Parameters[2].Values[0])
+	assert.Equal(t, "0.01", *comparison.Parameters[2].Values[1])
+	assert.True(t, comparison.Parameters[2].Different)
+
+	// Metrics are reported for the step that produced them
+	assert.Len(t, comparison.Metrics, 2)
+	assert.Equal(t, "train", comparison.Metrics[0].Step)
+	assert.Equal(t, "accuracy", comparison.Metrics[0].Name)
+	assert.Equal(t, "0.9", *comparison.Metrics[0].Values[0])
+	assert.Nil(t, comparison.Metrics[0].Values[1])
+
+	// Only pods are steps, they are matched by name
+	assert.Len(t, comparison.Steps, 2)
+	assert.Equal(t, "prepare", comparison.Steps[0].Name)
+	assert.False(t, comparison.Steps[0].Different)
+	assert.Equal(t, 10*time.Minute, comparison.Steps[0].Values[1].Duration)
+	assert.Equal(t, "train", comparison.Steps[1].Name)
+	assert.True(t, comparison.Steps[1].Different)
+	assert.Equal(t, "model", comparison.Steps[1].Values[0].Artifacts[0].Name)
+}
+
+// TestCompareWorkflowExecutions_NoGraph makes sure executions without a graph, such as old ones argo removed, are still compared
+func TestCompareWorkflowExecutions_NoGraph(t *testing.T) {
+	now := time.Date(2020, 10, 30, 12, 0, 0, 0, time.UTC)
+	startedAt := now.Add(-time.Hour)
+
+	inputs := []*comparisonInput{
+		{
+			workflow: &WorkflowExecution{
+				UID:              "old",
+				Phase:            wfv1.NodeSucceeded,
+				StartedAt:        &startedAt,
+				FinishedAt:       &now,
+				WorkflowTemplate: &WorkflowTemplate{UID: "train", Version: 1},
+			},
+			parameters: []Parameter{{Name: "epochs", Value: ptr.String("10")}},
+			metrics:    map[string][]*Metric{},
+		},
+		{
+			workflow: &WorkflowExecution{
+				UID:              "new",
+				Phase:            wfv1.NodeSucceeded,
+				StartedAt:        &startedAt,
+				FinishedAt:       &now,
+				WorkflowTemplate: &WorkflowTemplate{UID: "train", Version: 2},
+			},
+			parameters: []Parameter{{Name: "epochs", Value: ptr.String("20")}},
+			graph: &WorkflowExecutionGraph{Nodes: []*WorkflowExecutionNode{
+				{DisplayName: "train", Type: wfv1.NodeTypePod, PodName: "pod-train", Phase: wfv1.NodeSucceeded},
+			}},
+			metrics: map[string][]*Metric{},
+		},
+	}
+
+	comparison := compareWorkflowExecutions(inputs, now)
+
+	assert.Equal(t, time.Hour, comparison.Executions[0].Duration)
+	assert.Equal(t, int64(1), comparison.Executions[0].WorkflowTemplateVersion)
+	assert.Equal(t, "10", *comparison.Parameters[0].Values[0])
+	assert.Len(t, comparison.Steps, 1)
+	assert.Nil(t, comparison.Steps[0].Values[0])
+	assert.True(t, comparison.Steps[0].Different)
+}
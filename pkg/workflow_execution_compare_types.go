@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// WorkflowExecutionComparison shows workflow executions side by side.
+// The values of every row are in the order of Executions, a nil value means the execution doesn't have it.
+type WorkflowExecutionComparison struct {
+	Executions []*ComparedWorkflowExecution
+	Parameters []*ComparisonRow
+	// Metrics are the metrics each step reported, see GetWorkflowExecutionMetrics
+	Metrics []*ComparisonRow
+	Steps   []*ComparedStep
+}
+
+// ComparedWorkflowExecution is a workflow execution of a comparison, with the template version it ran
+type ComparedWorkflowExecution struct {
+	UID                       string
+	Name                      string
+	Phase                     wfv1.NodePhase
+	WorkflowTemplateUID       string
+	WorkflowTemplateName      string
+	WorkflowTemplateVersion   int64
+	WorkflowTemplateVersionID uint64
+	StartedAt                 *time.Time
+	FinishedAt                *time.Time
+	// Duration is until now for executions that have not finished
+	Duration time.Duration
+}
+
+// ComparisonRow is a parameter or metric of each workflow execution.
+// Different is true if the executions don't all have the same value.
+type ComparisonRow struct {
+	// Step is the step that reported a metric, it is empty for parameters
+	Step      string
+	Name      string
+	Values    []*string
+	Different bool
+}
+
+// ComparedStep is a step of each workflow execution, steps are matched by name.
+// Different is true if the executions don't all have the step with the same phase and output artifacts.
+type ComparedStep struct {
+	Name      string
+	Values    []*ComparedStepValue
+	Different bool
+}
+
+// ComparedStepValue is how a step ran in one workflow execution
+type ComparedStepValue struct {
+	Phase      wfv1.NodePhase
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	// Duration is until now for steps that have not finished
+	Duration  time.Duration
+	Artifacts []*WorkflowExecutionNodeArtifact
+}
+
+// comparisonInput is what a comparison needs to know about one workflow execution
+type comparisonInput struct {
+	workflow   *WorkflowExecution
+	parameters []Parameter
+	// graph is nil if the workflow execution has no nodes, e.g. if it is queued
+	graph *WorkflowExecutionGraph
+	// metrics are keyed by pod name
+	metrics map[string][]*Metric
+}
@@ -0,0 +1,355 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	// WorkflowExecutionResyncPeriod is how often the controller reconciles every workflow again, in case an update was missed
+	WorkflowExecutionResyncPeriod = 10 * time.Minute
+	// maxWorkflowExecutionReconcileRetries is how many times a failed reconciliation is retried before it waits for the next resync
+	maxWorkflowExecutionReconcileRetries = 5
+	// workflowExecutionDriftRetryPeriod is how often listing the workflow executions that have not finished is retried if it fails
+	workflowExecutionDriftRetryPeriod = 30 * time.Second
+)
+
+// terminalWorkflowExecutionPhases are the phases of workflow executions that never change, unless the execution is retried.
+// Retrying sets the phase in the database before argo reports the workflow as running again.
+var terminalWorkflowExecutionPhases = map[wfv1.NodePhase]bool{
+	wfv1.NodeSucceeded: true,
+	wfv1.NodeFailed:    true,
+	wfv1.NodeError:     true,
+	wfv1.NodeSkipped:   true,
+	"Terminated":       true,
+}
+
+// argoWorkflowExecutionPhase returns the phase the database has for the argo workflow
+func argoWorkflowExecutionPhase(wf *wfv1.Workflow) wfv1.NodePhase {
+	switch wf.Status.Phase {
+	case "":
+		return wfv1.NodePending
+	case wfv1.NodeRunning:
+		if wf.Spec.Suspend != nil && *wf.Spec.Suspend {
+			return WorkflowExecutionSuspended
+		}
+	}
+
+	return wf.Status.Phase
+}
+
+// restartedWorkflowExecutionPhases are the phases argo moves a finished workflow to when it is retried
+var restartedWorkflowExecutionPhases = map[wfv1.NodePhase]bool{
+	wfv1.NodeRunning:   true,
+	wfv1.NodeSucceeded: true,
+}
+
+// argoWorkflowRestarted returns true if the argo workflow started or finished after the time the workflow execution finished.
+// Retried workflows keep their start time, but the nodes that are run again start after it.
+func argoWorkflowRestarted(wf *wfv1.Workflow, finishedAt time.Time) bool {
+	if wf.Status.StartedAt.After(finishedAt) || (wf.Status.Phase.Completed() && wf.Status.FinishedAt.After(finishedAt)) {
+		return true
+	}
+
+	for _, node := range wf.Status.Nodes {
+		if node.StartedAt.After(finishedAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// workflowExecutionStatusUpdate returns the columns of the workflow execution that differ from its argo workflow,
+// it is empty if the workflow execution is up to date.
+//
+// Queued workflow executions are skipped as they are not submitted to argo yet.
+// Terminal phases are kept, the exit handler reports them while argo still runs it, and terminated executions finish as failed in argo.
+// Unless argo runs or succeeds the workflow again after the execution finished, e.g. when a retry was overwritten by an outdated event.
+// Timestamps are only set if they are missing, so the ones reported by the status callers are kept.
+func workflowExecutionStatusUpdate(workflowExecution *WorkflowExecution, wf *wfv1.Workflow) sq.Eq {
+	fieldMap := sq.Eq{}
+	if workflowExecution.Phase == WorkflowExecutionQueued {
+		return fieldMap
+	}
+
+	phase := argoWorkflowExecutionPhase(wf)
+	terminal := terminalWorkflowExecutionPhases[workflowExecution.Phase]
+	restarted := terminal && workflowExecution.Phase != phase && restartedWorkflowExecutionPhases[phase] &&
+		workflowExecution.FinishedAt != nil && argoWorkflowRestarted(wf, *workflowExecution.FinishedAt)
+	if (!terminal || restarted) && workflowExecution.Phase != phase {
+		fieldMap["phase"] = phase
+	}
+
+	if workflowExecution.StartedAt == nil && !wf.Status.StartedAt.IsZero() {
+		fieldMap["started_at"] = wf.Status.StartedAt.UTC()
+	}
+
+	if (workflowExecution.FinishedAt == nil || restarted) && !wf.Status.FinishedAt.IsZero() && wf.Status.Phase.Completed() {
+		fieldMap["finished_at"] = wf.Status.FinishedAt.UTC()
+	} else if restarted {
+		fieldMap["finished_at"] = nil
+	}
+
+	return fieldMap
+}
+
+// getSyncedWorkflowExecution returns the workflow execution the controller keeps in sync with the argo workflow, nil if there is none
+func (c *Client) getSyncedWorkflowExecution(namespace, name string) (*WorkflowExecution, error) {
+	workflowExecutions := make([]*WorkflowExecution, 0)
+	query := sb.Select(getWorkflowExecutionColumns()...).
+		From("workflow_executions").
+		Where(sq.Eq{
+			"namespace":   namespace,
+			"name":        name,
+			"is_archived": false,
+		})
+	if err := c.DB.Selectx(&workflowExecutions, query); err != nil {
+		return nil, err
+	}
+
+	if len(workflowExecutions) == 0 {
+		return nil, nil
+	}
+
+	return workflowExecutions[0], nil
+}
+
+// updateSyncedWorkflowExecution updates the workflow execution if its phase is still the one it was loaded with.
+// The notification of the new phase is only emitted by the server whose update succeeded.
+func (c *Client) updateSyncedWorkflowExecution(namespace string, workflowExecution *WorkflowExecution, fieldMap sq.Eq) error {
+	result, err := sb.Update("workflow_executions").
+		SetMap(fieldMap).
+		Where(sq.Eq{
+			"id":    workflowExecution.ID,
+			"phase": workflowExecution.Phase,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	phase, ok := fieldMap["phase"].(wfv1.NodePhase)
+	if rowsAffected == 0 || !ok {
+		return nil
+	}
+
+	c.notifyWorkflowExecutionPhase(namespace, &notificationSource{
+		UID:    workflowExecution.UID,
+		Name:   workflowExecution.Name,
+		Phase:  string(workflowExecution.Phase),
+		Labels: workflowExecution.Labels,
+	}, phase)
+
+	return nil
+}
+
+// reconcileWorkflowExecution updates the workflow execution of the argo workflow to match it
+func (c *Client) reconcileWorkflowExecution(wf *wfv1.Workflow) error {
+	workflowExecution, err := c.getSyncedWorkflowExecution(wf.Namespace, wf.Name)
+	if err != nil || workflowExecution == nil {
+		return err
+	}
+
+	// Keep the nodes so the graph can be viewed after argo removes the workflow
+	if wf.Status.Phase.Completed() {
+		if err := c.saveFinishedWorkflowExecutionManifest(wf.Namespace, wf.Name, wf); err != nil {
+			return err
+		}
+	}
+
+	fieldMap := workflowExecutionStatusUpdate(workflowExecution, wf)
+	if len(fieldMap) == 0 {
+		return nil
+	}
+
+	return c.updateSyncedWorkflowExecution(wf.Namespace, workflowExecution, fieldMap)
+}
+
+// reconcileMissingWorkflowExecution marks the workflow execution of a deleted argo workflow as errored if it has not finished.
+// The workflow is fetched again first, so an execution whose workflow is not in the cache yet is not affected.
+func (c *Client) reconcileMissingWorkflowExecution(namespace, name string) error {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(name, metav1.GetOptions{})
+	if err == nil {
+		return c.reconcileWorkflowExecution(wf)
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	workflowExecution, err := c.getSyncedWorkflowExecution(namespace, name)
+	if err != nil || workflowExecution == nil {
+		return err
+	}
+
+	if workflowExecution.Phase == WorkflowExecutionQueued || terminalWorkflowExecutionPhases[workflowExecution.Phase] {
+		return nil
+	}
+
+	fieldMap := sq.Eq{
+		"phase": wfv1.NodeError,
+	}
+	if workflowExecution.FinishedAt == nil {
+		fieldMap["finished_at"] = time.Now().UTC()
+	}
+
+	return c.updateSyncedWorkflowExecution(namespace, workflowExecution, fieldMap)
+}
+
+// listActiveWorkflowExecutionKeys returns the namespace/name keys of the workflow executions that have not finished
+func (c *Client) listActiveWorkflowExecutionKeys() ([]string, error) {
+	rows := make([]struct {
+		Namespace string
+		Name      string
+	}, 0)
+	query := sb.Select("namespace", "name").
+		From("workflow_executions").
+		Where(sq.Eq{
+			"phase":       activeWorkflowExecutionPhases,
+			"is_archived": false,
+		})
+	if err := c.DB.Selectx(&rows, query); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, row.Namespace+"/"+row.Name)
+	}
+
+	return keys, nil
+}
+
+// RunWorkflowExecutionController keeps the phase and timestamps of workflow executions in the database in sync with
+// their argo workflows until stopCh is closed.
+//
+// It watches the workflows created by onepanel in every namespace. Once the watch is synced, every workflow execution
+// that has not finished is reconciled too, which repairs the ones that changed or were deleted while no server was watching.
+func (c *Client) RunWorkflowExecutionController(stopCh <-chan struct{}) error {
+	listOptions := func(options *metav1.ListOptions) {
+		options.LabelSelector = workflowTemplateUIDLabelKey
+	}
+	listWatch := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			listOptions(&options)
+			return c.ArgoprojV1alpha1().Workflows(metav1.NamespaceAll).List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			listOptions(&options)
+			return c.ArgoprojV1alpha1().Workflows(metav1.NamespaceAll).Watch(options)
+		},
+	}
+
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "workflow-executions")
+	defer queue.ShutDown()
+
+	informer := cache.NewSharedIndexInformer(listWatch, &wfv1.Workflow{}, WorkflowExecutionResyncPeriod, cache.Indexers{})
+	enqueue := func(obj interface{}) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			log.Errorf("Unable to get the key of workflow: %v", err)
+			return
+		}
+		queue.Add(key)
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			enqueue(newObj)
+		},
+		DeleteFunc: enqueue,
+	})
+
+	go informer.Run(stopCh)
+
+	// The worker runs even if the workflow executions that have not finished can't be listed yet,
+	// keys of workflows that are not in the cache are fetched again before they are treated as deleted.
+	go func() {
+		for c.processWorkflowExecutionKey(queue, informer.GetIndexer()) {
+		}
+	}()
+
+	if !cache.WaitForCacheSync(stopCh, informer.HasSynced) {
+		return fmt.Errorf("workflow cache did not sync")
+	}
+
+	err := wait.PollImmediateUntil(workflowExecutionDriftRetryPeriod, func() (bool, error) {
+		keys, err := c.listActiveWorkflowExecutionKeys()
+		if err != nil {
+			log.Errorf("Unable to list the workflow executions that have not finished: %v", err)
+			return false, nil
+		}
+		for _, key := range keys {
+			queue.Add(key)
+		}
+		return true, nil
+	}, stopCh)
+	if err != nil && err != wait.ErrWaitTimeout {
+		return err
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+// processWorkflowExecutionKey reconciles the next key of the queue, it returns false once the queue is shut down
+func (c *Client) processWorkflowExecutionKey(queue workqueue.RateLimitingInterface, indexer cache.Indexer) bool {
+	item, shutdown := queue.Get()
+	if shutdown {
+		return false
+	}
+	defer queue.Done(item)
+
+	key := item.(string)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		queue.Forget(item)
+		return true
+	}
+
+	obj, exists, err := indexer.GetByKey(key)
+	if err == nil {
+		if exists {
+			err = c.reconcileWorkflowExecution(obj.(*wfv1.Workflow))
+		} else {
+			err = c.reconcileMissingWorkflowExecution(namespace, name)
+		}
+	}
+
+	if err == nil {
+		queue.Forget(item)
+		return true
+	}
+
+	if queue.NumRequeues(item) < maxWorkflowExecutionReconcileRetries {
+		queue.AddRateLimited(item)
+		return true
+	}
+
+	queue.Forget(item)
+	log.WithFields(log.Fields{
+		"Namespace": namespace,
+		"Name":      name,
+		"Error":     err.Error(),
+	}).Error("Unable to reconcile workflow execution.")
+
+	return true
+}
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestArgoWorkflowExecutionPhase(t *testing.T) {
+	wf := &wfv1.Workflow{}
+	assert.Equal(t, wfv1.NodePending, argoWorkflowExecutionPhase(wf))
+
+	wf.Status.Phase = wfv1.NodeRunning
+	assert.Equal(t, wfv1.NodeRunning, argoWorkflowExecutionPhase(wf))
+
+	wf.Spec.Suspend = ptr.Bool(true)
+	assert.Equal(t, WorkflowExecutionSuspended, argoWorkflowExecutionPhase(wf))
+
+	wf.Status.Phase = wfv1.NodeFailed
+	assert.Equal(t, wfv1.NodeFailed, argoWorkflowExecutionPhase(wf))
+}
+
+func TestWorkflowExecutionStatusUpdate(t *testing.T) {
+	startedAt := time.Date(2020, 10, 29, 9, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.Add(time.Hour)
+	wf := &wfv1.Workflow{
+		Status: wfv1.WorkflowStatus{
+			Phase:      wfv1.NodeSucceeded,
+			StartedAt:  metav1.NewTime(startedAt),
+			FinishedAt: metav1.NewTime(finishedAt),
+		},
+	}
+
+	t.Run("Stale", func(t *testing.T) {
+		fieldMap := workflowExecutionStatusUpdate(&WorkflowExecution{Phase: wfv1.NodeRunning}, wf)
+		assert.Equal(t, wfv1.NodeSucceeded, fieldMap["phase"])
+		assert.Equal(t, startedAt, fieldMap["started_at"])
+		assert.Equal(t, finishedAt, fieldMap["finished_at"])
+	})
+
+	t.Run("UpToDate", func(t *testing.T) {
+		reportedAt := startedAt.Add(time.Minute)
+		fieldMap := workflowExecutionStatusUpdate(&WorkflowExecution{
+			Phase:      wfv1.NodeSucceeded,
+			StartedAt:  &reportedAt,
+			FinishedAt: &reportedAt,
+		}, wf)
+		assert.Empty(t, fieldMap)
+	})
+
+	t.Run("Terminal", func(t *testing.T) {
+		running := &wfv1.Workflow{Status: wfv1.WorkflowStatus{Phase: wfv1.NodeRunning, StartedAt: metav1.NewTime(startedAt)}}
+		fieldMap := workflowExecutionStatusUpdate(&WorkflowExecution{Phase: "Terminated", StartedAt: &startedAt}, running)
+		assert.Empty(t, fieldMap)
+	})
+
+	t.Run("Restarted", func(t *testing.T) {
+		// An outdated event marked the retried execution as failed
+		failedAt := startedAt.Add(time.Minute)
+		failed := &WorkflowExecution{Phase: wfv1.NodeFailed, StartedAt: &startedAt, FinishedAt: &failedAt}
+
+		fieldMap := workflowExecutionStatusUpdate(failed, wf)
+		assert.Equal(t, wfv1.NodeSucceeded, fieldMap["phase"])
+		assert.Equal(t, finishedAt, fieldMap["finished_at"])
+
+		retried := &wfv1.Workflow{Status: wfv1.WorkflowStatus{
+			Phase:     wfv1.NodeRunning,
+			StartedAt: metav1.NewTime(startedAt),
+			Nodes: wfv1.Nodes{
+				"retry": wfv1.NodeStatus{StartedAt: metav1.NewTime(failedAt.Add(time.Minute))},
+			},
+		}}
+		fieldMap = workflowExecutionStatusUpdate(failed, retried)
+		assert.Equal(t, wfv1.NodeRunning, fieldMap["phase"])
+		assert.Contains(t, fieldMap, "finished_at")
+		assert.Nil(t, fieldMap["finished_at"])
+
+		// The exit handler reported the phase before argo finished the workflow
+		reported := &WorkflowExecution{Phase: wfv1.NodeFailed, StartedAt: &startedAt, FinishedAt: &finishedAt}
+		assert.Empty(t, workflowExecutionStatusUpdate(reported, &wfv1.Workflow{Status: wfv1.WorkflowStatus{
+			Phase:     wfv1.NodeRunning,
+			StartedAt: metav1.NewTime(startedAt),
+		}}))
+	})
+
+	t.Run("Queued", func(t *testing.T) {
+		fieldMap := workflowExecutionStatusUpdate(&WorkflowExecution{Phase: WorkflowExecutionQueued}, wf)
+		assert.Empty(t, fieldMap)
+	})
+}
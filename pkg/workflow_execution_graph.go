@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SaveWorkflowExecutionManifest stores the argo workflow, including its status, with the workflow execution.
+// This lets the execution be inspected after argo has garbage collected the workflow.
+func (c *Client) SaveWorkflowExecutionManifest(namespace, uid string, wf *wfv1.Workflow) error {
+	manifest, err := json.Marshal(wf)
+	if err != nil {
+		return err
+	}
+
+	_, err = sb.Update("workflow_executions").
+		Set("manifest", string(manifest)).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"name":      uid,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// saveFinishedWorkflowExecutionManifest stores the argo workflow of a finished workflow execution, unless the stored one finished at the same time or later.
+// The exit handler saves the manifest while argo still runs it, so it is replaced when argo reports the workflow as completed,
+// and a retried workflow replaces the manifest of the run before the retry once it finishes again.
+func (c *Client) saveFinishedWorkflowExecutionManifest(namespace, uid string, wf *wfv1.Workflow) error {
+	manifest, err := json.Marshal(wf)
+	if err != nil {
+		return err
+	}
+
+	_, err = sb.Update("workflow_executions").
+		Set("manifest", string(manifest)).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"name":      uid,
+		}).
+		Where(sq.Or{
+			sq.Eq{"manifest": nil},
+			sq.Expr("manifest::jsonb #>> '{status,finishedAt}' IS NULL"),
+			sq.Expr("(manifest::jsonb #>> '{status,finishedAt}')::timestamptz < ?", wf.Status.FinishedAt.UTC()),
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// getStoredWorkflowExecutionManifest returns the argo workflow saved by SaveWorkflowExecutionManifest along with the
+// status recorded in the database, or nil if none was saved.
+func (c *Client) getStoredWorkflowExecutionManifest(namespace, uid string) (*wfv1.Workflow, *WorkflowExecutionStatus, error) {
+	manifest := sql.NullString{}
+	phase := sql.NullString{}
+	status := &WorkflowExecutionStatus{}
+	err := sb.Select("manifest", "phase", "started_at", "finished_at").
+		From("workflow_executions").
+		Where(sq.Eq{
+			"namespace":   namespace,
+			"name":        uid,
+			"is_archived": false,
+		}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&manifest, &phase, &status.StartedAt, &status.FinishedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	status.Phase = wfv1.NodePhase(phase.String)
+
+	if !manifest.Valid {
+		return nil, status, nil
+	}
+
+	wf := &wfv1.Workflow{}
+	if err := json.Unmarshal([]byte(manifest.String), wf); err != nil {
+		return nil, nil, err
+	}
+
+	return wf, status, nil
+}
+
+// GetWorkflowExecutionGraph returns the nodes of the workflow execution.
+// They come from argo if the workflow still exists, otherwise from the manifest saved when the execution finished.
+// The manifest is saved by the exit handler and by the workflow execution controller, not here, as graphs are read often.
+func (c *Client) GetWorkflowExecutionGraph(namespace, uid string) (*WorkflowExecutionGraph, error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err == nil {
+		return NewWorkflowExecutionGraph(wf), nil
+	}
+
+	if !errors.IsNotFound(err) {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to get workflow.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to get workflow.")
+	}
+
+	wf, status, err := c.getStoredWorkflowExecutionManifest(namespace, uid)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to load stored workflow manifest.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to load workflow.")
+	}
+	if wf == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	graph := NewWorkflowExecutionGraph(wf)
+	// The manifest is saved by the exit handler, while argo still reports the workflow as running
+	if !graph.Phase.Completed() && status.Phase.Completed() {
+		graph.Phase = status.Phase
+		graph.FinishedAt = nonZeroTime(status.FinishedAt)
+	}
+
+	return graph, nil
+}
+
+// nonZeroTime returns nil for a missing or zero time, so unset times are not reported as the zero date
+func nonZeroTime(t *time.Time) *time.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+
+	result := t.UTC()
+
+	return &result
+}
@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestGraphWorkflow() *wfv1.Workflow {
+	started := metav1.NewTime(time.Date(2020, 10, 1, 10, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(started.Add(time.Minute))
+	value := "0.95"
+
+	return &wfv1.Workflow{
+		Status: wfv1.WorkflowStatus{
+			Phase:     wfv1.NodeRunning,
+			StartedAt: started,
+			Nodes: map[string]wfv1.NodeStatus{
+				"test-train": {
+					ID:           "test-train",
+					Name:         "test.train",
+					DisplayName:  "train",
+					Type:         wfv1.NodeTypePod,
+					TemplateName: "train",
+					Phase:        wfv1.NodeSucceeded,
+					BoundaryID:   "test",
+					StartedAt:    later,
+					FinishedAt:   later,
+					Outputs: &wfv1.Outputs{
+						Parameters: []wfv1.Parameter{{Name: "accuracy", Value: &value}},
+						Artifacts: wfv1.Artifacts{{
+							Name: "model",
+							Path: "/tmp/model",
+							ArtifactLocation: wfv1.ArtifactLocation{
+								S3: &wfv1.S3Artifact{Key: "artifacts/test/model.tgz"},
+							},
+						}},
+					},
+				},
+				"test": {
+					ID:          "test",
+					Name:        "test",
+					DisplayName: "test",
+					Type:        wfv1.NodeTypeDAG,
+					Phase:       wfv1.NodeRunning,
+					StartedAt:   started,
+					Children:    []string{"test-train"},
+				},
+			},
+		},
+	}
+}
+
+// TestNewWorkflowExecutionGraph makes sure argo node statuses are converted and ordered by start time
+func TestNewWorkflowExecutionGraph(t *testing.T) {
+	graph := NewWorkflowExecutionGraph(newTestGraphWorkflow())
+
+	assert.Equal(t, wfv1.NodeRunning, graph.Phase)
+	assert.Nil(t, graph.FinishedAt)
+	assert.Len(t, graph.Nodes, 2)
+
+	dag := graph.Nodes[0]
+	assert.Equal(t, "test", dag.ID)
+	assert.Equal(t, "", dag.PodName)
+	assert.Equal(t, []string{"test-train"}, dag.Children)
+	assert.Nil(t, dag.FinishedAt)
+
+	pod := graph.Nodes[1]
+	assert.Equal(t, "test-train", pod.PodName)
+	assert.Equal(t, "train", pod.TemplateName)
+	assert.Equal(t, []string{}, pod.Children)
+	assert.Nil(t, pod.Inputs)
+	assert.Equal(t, "0.95", pod.Outputs.Parameters[0].Value)
+	assert.Equal(t, "artifacts/test/model.tgz", pod.Outputs.Artifacts[0].Key)
+}
+
+// TestClient_GetWorkflowExecutionGraph_Stored makes sure the graph is loaded from the database once argo removed the workflow
+func TestClient_GetWorkflowExecutionGraph_Stored(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	we, err := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "test"}, wt)
+	assert.Nil(t, err)
+
+	// Not saved yet, and argo's fake client doesn't know about it
+	_, err = c.GetWorkflowExecutionGraph(namespace, "not-exist")
+	assert.NotNil(t, err)
+
+	err = c.SaveWorkflowExecutionManifest(namespace, we.Name, newTestGraphWorkflow())
+	assert.Nil(t, err)
+	err = c.FinishWorkflowExecutionStatisticViaExitHandler(namespace, we.Name, int64(wt.ID), wfv1.NodeSucceeded, time.Now())
+	assert.Nil(t, err)
+
+	err = c.ArgoprojV1alpha1().Workflows(namespace).Delete(we.Name, nil)
+	assert.Nil(t, err)
+
+	graph, err := c.GetWorkflowExecutionGraph(namespace, we.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, wfv1.NodeSucceeded, graph.Phase)
+	assert.NotNil(t, graph.FinishedAt)
+	assert.Len(t, graph.Nodes, 2)
+}
+
+// TestClient_GetWorkflowExecutionGraph_Retried makes sure the graph of a retried execution that finished again replaces the one before the retry
+func TestClient_GetWorkflowExecutionGraph_Retried(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	we, err := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "test"}, wt)
+	assert.Nil(t, err)
+
+	failed := newTestGraphWorkflow()
+	failed.Status.Phase = wfv1.NodeFailed
+	failed.Status.FinishedAt = metav1.NewTime(failed.Status.StartedAt.Add(time.Hour))
+	assert.Nil(t, c.saveFinishedWorkflowExecutionManifest(namespace, we.Name, failed))
+
+	succeeded := newTestGraphWorkflow()
+	succeeded.Status.Phase = wfv1.NodeSucceeded
+	succeeded.Status.FinishedAt = metav1.NewTime(failed.Status.FinishedAt.Add(time.Hour))
+	assert.Nil(t, c.saveFinishedWorkflowExecutionManifest(namespace, we.Name, succeeded))
+
+	// An outdated event of the run before the retry doesn't replace it again
+	assert.Nil(t, c.saveFinishedWorkflowExecutionManifest(namespace, we.Name, failed))
+
+	err = c.ArgoprojV1alpha1().Workflows(namespace).Delete(we.Name, nil)
+	assert.Nil(t, err)
+
+	graph, err := c.GetWorkflowExecutionGraph(namespace, we.Name)
+	assert.Nil(t, err)
+	assert.Equal(t, wfv1.NodeSucceeded, graph.Phase)
+}
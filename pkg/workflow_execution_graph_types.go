@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"sort"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+)
+
+// WorkflowExecutionGraph is the runtime state of the nodes of a workflow execution
+type WorkflowExecutionGraph struct {
+	Phase      wfv1.NodePhase
+	Message    string
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	Nodes      []*WorkflowExecutionNode
+}
+
+// WorkflowExecutionNode is a step, dag task, pod or other node of a workflow execution
+type WorkflowExecutionNode struct {
+	ID           string
+	Name         string
+	DisplayName  string
+	Type         wfv1.NodeType
+	TemplateName string
+	Phase        wfv1.NodePhase
+	BoundaryID   string
+	Message      string
+	StartedAt    *time.Time
+	FinishedAt   *time.Time
+	// PodName is only set for nodes that run a pod
+	PodName  string
+	Children []string
+	Inputs   *WorkflowExecutionNodeIO
+	Outputs  *WorkflowExecutionNodeIO
+}
+
+// WorkflowExecutionNodeIO are the input or output parameters and artifacts of a node
+type WorkflowExecutionNodeIO struct {
+	Parameters []*WorkflowExecutionNodeParameter
+	Artifacts  []*WorkflowExecutionNodeArtifact
+}
+
+// WorkflowExecutionNodeParameter is a parameter passed to or produced by a node
+type WorkflowExecutionNodeParameter struct {
+	Name  string
+	Value string
+}
+
+// WorkflowExecutionNodeArtifact is an artifact passed to or produced by a node
+type WorkflowExecutionNodeArtifact struct {
+	Name string
+	Path string
+	// Key is the location of the artifact in the artifact repository, if it is stored in one
+	Key string
+}
+
+func newWorkflowExecutionNodeParameters(parameters []wfv1.Parameter) []*WorkflowExecutionNodeParameter {
+	result := make([]*WorkflowExecutionNodeParameter, 0)
+	for _, parameter := range parameters {
+		value := ""
+		if parameter.Value != nil {
+			value = *parameter.Value
+		}
+
+		result = append(result, &WorkflowExecutionNodeParameter{
+			Name:  parameter.Name,
+			Value: value,
+		})
+	}
+
+	return result
+}
+
+func newWorkflowExecutionNodeArtifacts(artifacts wfv1.Artifacts) []*WorkflowExecutionNodeArtifact {
+	result := make([]*WorkflowExecutionNodeArtifact, 0)
+	for _, artifact := range artifacts {
+		key := ""
+		if artifact.S3 != nil {
+			key = artifact.S3.Key
+		} else if artifact.GCS != nil {
+			key = artifact.GCS.Key
+		}
+
+		result = append(result, &WorkflowExecutionNodeArtifact{
+			Name: artifact.Name,
+			Path: artifact.Path,
+			Key:  key,
+		})
+	}
+
+	return result
+}
+
+// newWorkflowExecutionNode converts the argo node status
+func newWorkflowExecutionNode(node *wfv1.NodeStatus) *WorkflowExecutionNode {
+	result := &WorkflowExecutionNode{
+		ID:           node.ID,
+		Name:         node.Name,
+		DisplayName:  node.DisplayName,
+		Type:         node.Type,
+		TemplateName: node.TemplateName,
+		Phase:        node.Phase,
+		BoundaryID:   node.BoundaryID,
+		Message:      node.Message,
+		StartedAt:    nonZeroTime(&node.StartedAt.Time),
+		FinishedAt:   nonZeroTime(&node.FinishedAt.Time),
+		Children:     node.Children,
+	}
+
+	if result.Children == nil {
+		result.Children = make([]string, 0)
+	}
+
+	// Argo names the pods of pod nodes after the node id
+	if node.Type == wfv1.NodeTypePod {
+		result.PodName = node.ID
+	}
+
+	if node.Inputs != nil {
+		result.Inputs = &WorkflowExecutionNodeIO{
+			Parameters: newWorkflowExecutionNodeParameters(node.Inputs.Parameters),
+			Artifacts:  newWorkflowExecutionNodeArtifacts(node.Inputs.Artifacts),
+		}
+	}
+
+	if node.Outputs != nil {
+		result.Outputs = &WorkflowExecutionNodeIO{
+			Parameters: newWorkflowExecutionNodeParameters(node.Outputs.Parameters),
+			Artifacts:  newWorkflowExecutionNodeArtifacts(node.Outputs.Artifacts),
+		}
+	}
+
+	return result
+}
+
+// NewWorkflowExecutionGraph builds the graph from the status of the argo workflow.
+// Nodes are ordered by start time, then id, so the order is stable between calls.
+func NewWorkflowExecutionGraph(wf *wfv1.Workflow) *WorkflowExecutionGraph {
+	graph := &WorkflowExecutionGraph{
+		Phase:      wfv1.NodePhase(wf.Status.Phase),
+		Message:    wf.Status.Message,
+		StartedAt:  nonZeroTime(&wf.Status.StartedAt.Time),
+		FinishedAt: nonZeroTime(&wf.Status.FinishedAt.Time),
+		Nodes:      make([]*WorkflowExecutionNode, 0, len(wf.Status.Nodes)),
+	}
+
+	for id := range wf.Status.Nodes {
+		node := wf.Status.Nodes[id]
+		graph.Nodes = append(graph.Nodes, newWorkflowExecutionNode(&node))
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		left, right := graph.Nodes[i], graph.Nodes[j]
+		if left.StartedAt != nil && right.StartedAt != nil && !left.StartedAt.Equal(*right.StartedAt) {
+			return left.StartedAt.Before(*right.StartedAt)
+		}
+		if (left.StartedAt == nil) != (right.StartedAt == nil) {
+			return left.StartedAt != nil
+		}
+
+		return left.ID < right.ID
+	})
+
+	return graph
+}
@@ -0,0 +1,339 @@
+package v1
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/s3"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultLogPageSize is the number of bytes in a log page if no limit is requested
+	defaultLogPageSize = 1024 * 1024
+	// maxLogPageSize is the largest number of bytes that can be requested for a log page
+	maxLogPageSize = 10 * 1024 * 1024
+)
+
+// parseLogLine returns the entry for a line of a log. Lines that start with a RFC3339 timestamp are split into the
+// timestamp and content, other lines are kept as is.
+func parseLogLine(line string) *LogEntry {
+	parts := strings.SplitN(line, " ", 2)
+	if len(parts) == 2 {
+		timestamp, err := time.Parse(time.RFC3339, parts[0])
+		if err == nil {
+			return &LogEntry{
+				Timestamp: timestamp,
+				Content:   parts[1],
+			}
+		}
+	}
+
+	return &LogEntry{Content: line}
+}
+
+// logFilter decides which log entries are kept according to LogOptions
+type logFilter struct {
+	since     *time.Time
+	substring string
+	regex     *regexp.Regexp
+	// afterSince is true once an entry at or after since was seen.
+	// Entries without a timestamp continue the previous entry, so they follow its decision.
+	afterSince bool
+}
+
+func newLogFilter(opts *LogOptions) (*logFilter, error) {
+	filter := &logFilter{
+		afterSince: true,
+	}
+	if opts == nil {
+		return filter, nil
+	}
+
+	if opts.Since != nil {
+		filter.since = opts.Since
+		filter.afterSince = false
+	}
+
+	if opts.FilterRegex && opts.Filter != "" {
+		regex, err := regexp.Compile(opts.Filter)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Invalid filter regular expression.")
+		}
+		filter.regex = regex
+	} else {
+		filter.substring = opts.Filter
+	}
+
+	return filter, nil
+}
+
+// matches returns true if the entry should be kept
+func (f *logFilter) matches(entry *LogEntry) bool {
+	if f.since != nil && !entry.Timestamp.IsZero() {
+		f.afterSince = !entry.Timestamp.Before(*f.since)
+	}
+	if !f.afterSince {
+		return false
+	}
+
+	if f.regex != nil {
+		return f.regex.MatchString(entry.Content)
+	}
+
+	return strings.Contains(entry.Content, f.substring)
+}
+
+// readLogEntries reads the lines of the log, and calls send with the entries that match the filter.
+// If tailLines is positive only the last matching entries are sent. Reading stops if send returns false.
+func readLogEntries(stream io.Reader, filter *logFilter, tailLines int64, send func(entry *LogEntry) bool) error {
+	reader := bufio.NewReader(stream)
+
+	var tail []*LogEntry
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			entry := parseLogLine(line)
+			if filter.matches(entry) {
+				if tailLines > 0 {
+					tail = append(tail, entry)
+					if int64(len(tail)) > tailLines {
+						tail = tail[1:]
+					}
+				} else if !send(entry) {
+					return nil
+				}
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range tail {
+		if !send(entry) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// readTailLines returns the tailLines readLogEntries keeps of a log opened with openContainerLog.
+// Kubernetes tails the log of a running pod, which is followed, so its entries can't wait for the end of the stream.
+func readTailLines(opts *LogOptions, completed bool) int64 {
+	if opts == nil || !completed {
+		return 0
+	}
+
+	return opts.TailLines
+}
+
+// getWorkflowPodNode returns the node of the pod in the workflow
+func (c *Client) getWorkflowPodNode(namespace, uid, podName string) (*wfv1.NodeStatus, error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"PodName":   podName,
+			"Error":     err.Error(),
+		}).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	node := wf.Status.Nodes[podName]
+
+	return &node, nil
+}
+
+// openArchivedLog opens the log of a completed container in the artifact repository, starting at the byte offset
+func (c *Client) openArchivedLog(namespace, uid, podName, containerName string, offset int64) (io.ReadCloser, error) {
+	config, err := c.GetNamespaceConfig(namespace)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":     namespace,
+			"UID":           uid,
+			"PodName":       podName,
+			"ContainerName": containerName,
+			"Error":         err.Error(),
+		}).Error("Can't get configuration.")
+		return nil, util.NewUserError(codes.NotFound, "Can't get configuration.")
+	}
+
+	switch {
+	case config.ArtifactRepository.S3 != nil:
+		s3Client, err := c.GetS3Client(namespace, config.ArtifactRepository.S3)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":     namespace,
+				"UID":           uid,
+				"PodName":       podName,
+				"ContainerName": containerName,
+				"Error":         err.Error(),
+			}).Error("Can't connect to S3 storage.")
+			return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
+		}
+
+		opts := s3.GetObjectOptions{}
+		if offset > 0 {
+			if err := opts.SetRange(offset, 0); err != nil {
+				return nil, util.NewUserError(codes.InvalidArgument, "Invalid range.")
+			}
+		}
+
+		key := config.ArtifactRepository.S3.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
+		return s3Client.GetObject(config.ArtifactRepository.S3.Bucket, key, opts)
+	case config.ArtifactRepository.GCS != nil:
+		gcsClient, err := c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":     namespace,
+				"UID":           uid,
+				"PodName":       podName,
+				"ContainerName": containerName,
+				"Error":         err.Error(),
+			}).Error("Can't connect to GCS storage.")
+			return nil, util.NewUserError(codes.NotFound, "Can't connect to GCS storage.")
+		}
+
+		key := config.ArtifactRepository.GCS.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
+		return gcsClient.GetObjectRange(config.ArtifactRepository.GCS.Bucket, key, offset, -1)
+	}
+
+	return nil, util.NewUserError(codes.NotFound, "Artifact repository is not configured.")
+}
+
+// openLog opens the log of the container, from the artifact repository if the pod completed, from the pod otherwise.
+// follow only applies to running pods, and keeps the stream open for new lines.
+func (c *Client) openLog(namespace, uid, podName, containerName string, offset int64, follow bool, opts *LogOptions) (io.ReadCloser, error) {
+	node, err := c.getWorkflowPodNode(namespace, uid, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.openContainerLog(namespace, uid, podName, containerName, node.Completed(), offset, follow, opts)
+}
+
+// openContainerLog is openLog for a pod whose state is already known
+func (c *Client) openContainerLog(namespace, uid, podName, containerName string, completed bool, offset int64, follow bool, opts *LogOptions) (stream io.ReadCloser, err error) {
+	if completed {
+		stream, err = c.openArchivedLog(namespace, uid, podName, containerName, offset)
+	} else {
+		podLogOptions := &corev1.PodLogOptions{
+			Container:  containerName,
+			Follow:     follow,
+			Timestamps: true,
+		}
+		if opts != nil && opts.TailLines > 0 {
+			podLogOptions.TailLines = &opts.TailLines
+		}
+		if opts != nil && opts.Since != nil {
+			sinceTime := metav1.NewTime(*opts.Since)
+			podLogOptions.SinceTime = &sinceTime
+		}
+
+		stream, err = c.CoreV1().Pods(namespace).GetLogs(podName, podLogOptions).Stream()
+		if err == nil && offset > 0 {
+			if _, err = io.CopyN(ioutil.Discard, stream, offset); err == io.EOF {
+				err = nil
+			}
+		}
+	}
+	if err != nil {
+		if stream != nil {
+			stream.Close()
+		}
+		if _, ok := err.(*util.UserError); ok {
+			return nil, err
+		}
+
+		log.WithFields(log.Fields{
+			"Namespace":     namespace,
+			"UID":           uid,
+			"PodName":       podName,
+			"ContainerName": containerName,
+			"Error":         err.Error(),
+		}).Error("Error with logs.")
+		return nil, util.NewUserError(codes.NotFound, "Log not found.")
+	}
+
+	return stream, nil
+}
+
+// GetWorkflowExecutionLogPage returns the log entries that start in the limit bytes after offset.
+// Pages always end on a line boundary, so NextOffset can be used to request the next page.
+// A line longer than limit is returned whole.
+func (c *Client) GetWorkflowExecutionLogPage(namespace, uid, podName, containerName string, offset, limit int64, opts *LogOptions) (*LogPage, error) {
+	if offset < 0 {
+		return nil, util.NewUserError(codes.InvalidArgument, "Offset can't be negative.")
+	}
+	if limit <= 0 {
+		limit = defaultLogPageSize
+	}
+	if limit > maxLogPageSize {
+		limit = maxLogPageSize
+	}
+
+	filter, err := newLogFilter(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.openLog(namespace, uid, podName, containerName, offset, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	return readLogPage(stream, offset, limit, filter)
+}
+
+// readLogPage reads whole lines from stream, which starts at offset, until at least limit bytes are read
+func readLogPage(stream io.Reader, offset, limit int64, filter *logFilter) (*LogPage, error) {
+	page := &LogPage{
+		Entries:    make([]*LogEntry, 0),
+		NextOffset: offset,
+	}
+
+	reader := bufio.NewReader(stream)
+	for page.NextOffset-offset < limit {
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, util.NewUserError(codes.Unknown, "Unable to read log.")
+		}
+
+		if line != "" {
+			page.NextOffset += int64(len(line))
+			entry := parseLogLine(line)
+			if filter.matches(entry) {
+				page.Entries = append(page.Entries, entry)
+			}
+		}
+
+		if err == io.EOF {
+			page.Done = true
+			break
+		}
+	}
+
+	return page, nil
+}
+
+// GetWorkflowExecutionLogFile opens the complete log of the container, the caller must close it
+func (c *Client) GetWorkflowExecutionLogFile(namespace, uid, podName, containerName string) (io.ReadCloser, error) {
+	return c.openLog(namespace, uid, podName, containerName, 0, false, nil)
+}
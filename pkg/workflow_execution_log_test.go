@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testLog = `2020-10-01T10:00:00Z starting
+2020-10-01T10:00:01Z epoch 1 loss 0.9
+Traceback (most recent call last):
+2020-10-01T10:00:02Z epoch 2 loss 0.5
+2020-10-01T10:00:03Z done
+`
+
+func readTestLogEntries(t *testing.T, opts *LogOptions) []string {
+	filter, err := newLogFilter(opts)
+	assert.Nil(t, err)
+
+	var tailLines int64
+	if opts != nil {
+		tailLines = opts.TailLines
+	}
+
+	var lines []string
+	err = readLogEntries(strings.NewReader(testLog), filter, tailLines, func(entry *LogEntry) bool {
+		lines = append(lines, entry.Content)
+		return true
+	})
+	assert.Nil(t, err)
+
+	return lines
+}
+
+func TestParseLogLine(t *testing.T) {
+	entry := parseLogLine("2020-10-01T10:00:00Z hello world\n")
+	assert.Equal(t, time.Date(2020, 10, 1, 10, 0, 0, 0, time.UTC), entry.Timestamp.UTC())
+	assert.Equal(t, "hello world\n", entry.Content)
+
+	entry = parseLogLine("no timestamp here\n")
+	assert.True(t, entry.Timestamp.IsZero())
+	assert.Equal(t, "no timestamp here\n", entry.Content)
+}
+
+func TestReadLogEntries(t *testing.T) {
+	t.Run("All", func(t *testing.T) {
+		assert.Len(t, readTestLogEntries(t, nil), 5)
+	})
+
+	t.Run("Tail", func(t *testing.T) {
+		lines := readTestLogEntries(t, &LogOptions{TailLines: 2})
+		assert.Equal(t, []string{"epoch 2 loss 0.5\n", "done\n"}, lines)
+	})
+
+	t.Run("Since", func(t *testing.T) {
+		since := time.Date(2020, 10, 1, 10, 0, 1, 0, time.UTC)
+		lines := readTestLogEntries(t, &LogOptions{Since: &since})
+		// The line without a timestamp belongs to the line before it
+		assert.Equal(t, "epoch 1 loss 0.9\n", lines[0])
+		assert.Equal(t, "Traceback (most recent call last):\n", lines[1])
+		assert.Len(t, lines, 4)
+	})
+
+	t.Run("Substring", func(t *testing.T) {
+		lines := readTestLogEntries(t, &LogOptions{Filter: "epoch"})
+		assert.Len(t, lines, 2)
+	})
+
+	t.Run("Regex", func(t *testing.T) {
+		lines := readTestLogEntries(t, &LogOptions{Filter: `loss 0\.[0-5]`, FilterRegex: true, TailLines: 5})
+		assert.Equal(t, []string{"epoch 2 loss 0.5\n"}, lines)
+	})
+
+	t.Run("Invalid Regex", func(t *testing.T) {
+		_, err := newLogFilter(&LogOptions{Filter: "(", FilterRegex: true})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestReadLogPage(t *testing.T) {
+	filter, _ := newLogFilter(nil)
+
+	var offset int64
+	var pages int
+	var content string
+	for {
+		page, err := readLogPage(strings.NewReader(testLog[offset:]), offset, 40, filter)
+		assert.Nil(t, err)
+		pages++
+
+		for _, entry := range page.Entries {
+			assert.True(t, strings.HasSuffix(entry.Content, "\n"))
+			content += entry.Content
+		}
+
+		offset = page.NextOffset
+		if page.Done {
+			break
+		}
+	}
+
+	assert.Equal(t, int64(len(testLog)), offset)
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, "starting\nepoch 1 loss 0.9\nTraceback (most recent call last):\nepoch 2 loss 0.5\ndone\n", content)
+}
+
+func TestReadTailLines(t *testing.T) {
+	opts := &LogOptions{TailLines: 5}
+
+	assert.Equal(t, int64(5), readTailLines(opts, true))
+	assert.Equal(t, int64(0), readTailLines(opts, false))
+	assert.Equal(t, int64(0), readTailLines(nil, true))
+}
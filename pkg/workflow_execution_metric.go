@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// maxLoggedMetricPoints is the number of points that can be logged at once
+	maxLoggedMetricPoints = 1000
+	// maxMetricNameLength is the length of the name column
+	maxMetricNameLength = 255
+	// defaultMetricSeriesPoints is the number of points a series is downsampled to if no number is requested
+	defaultMetricSeriesPoints = 500
+	// maxMetricSeriesPoints is the largest number of points a series can be downsampled to
+	maxMetricSeriesPoints = 10000
+)
+
+// validateMetricPoints returns an error if a point can't be logged
+func validateMetricPoints(points []*MetricPoint) error {
+	if len(points) == 0 {
+		return util.NewUserError(codes.InvalidArgument, "At least one metric point is required.")
+	}
+	if len(points) > maxLoggedMetricPoints {
+		return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("At most %v metric points can be logged at once.", maxLoggedMetricPoints))
+	}
+
+	for _, point := range points {
+		if point.Name == "" || len(point.Name) > maxMetricNameLength {
+			return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Metric names must have 1 to %v characters.", maxMetricNameLength))
+		}
+		if math.IsNaN(point.Value) || math.IsInf(point.Value, 0) {
+			return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Metric '%v' at step %v is not a finite number.", point.Name, point.Step))
+		}
+	}
+
+	return nil
+}
+
+// groupMetricSeries groups rows ordered by node, name and step into series
+func groupMetricSeries(rows []*metricSeriesRow) []*MetricSeries {
+	result := make([]*MetricSeries, 0)
+
+	var series *MetricSeries
+	for _, row := range rows {
+		if series == nil || series.NodeID != row.NodeID || series.Name != row.Name {
+			series = &MetricSeries{
+				NodeID: row.NodeID,
+				Name:   row.Name,
+				Points: make([]*MetricSeriesPoint, 0),
+			}
+			result = append(result, series)
+		}
+
+		point := row.MetricSeriesPoint
+		series.Points = append(series.Points, &point)
+	}
+
+	return result
+}
+
+// getWorkflowExecutionID returns the database id of the workflow execution
+func (c *Client) getWorkflowExecutionID(namespace, uid string) (id uint64, err error) {
+	query := sb.Select("id").
+		From("workflow_executions").
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		})
+
+	if err = c.DB.Getx(&id, query); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, util.NewUserError(codes.NotFound, "Workflow execution not found.")
+		}
+		return 0, err
+	}
+
+	return
+}
+
+// LogWorkflowExecutionMetrics stores metric points logged by a running step of the workflow execution.
+// nodeID is the node of the step, which is the name of its pod.
+func (c *Client) LogWorkflowExecutionMetrics(namespace, uid, nodeID string, points []*MetricPoint) error {
+	if nodeID == "" {
+		return util.NewUserError(codes.InvalidArgument, "Node id is required.")
+	}
+	if err := validateMetricPoints(points); err != nil {
+		return err
+	}
+
+	id, err := c.getWorkflowExecutionID(namespace, uid)
+	if err != nil {
+		return err
+	}
+
+	query := sb.Insert("workflow_execution_metrics").
+		Columns("workflow_execution_id", "node_id", "name", "step", "value")
+	for _, point := range points {
+		query = query.Values(id, nodeID, point.Name, point.Step, point.Value)
+	}
+
+	if _, err := query.RunWith(c.DB).Exec(); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"NodeID":    nodeID,
+			"Error":     err.Error(),
+		}).Error("Unable to log metrics.")
+		return util.NewUserError(codes.Unknown, "Unable to log metrics.")
+	}
+
+	return nil
+}
+
+// GetWorkflowExecutionMetricSeries returns the metrics logged by the steps of the workflow execution.
+// Each series has at most opts.MaxPoints points, longer series are downsampled by averaging consecutive points.
+func (c *Client) GetWorkflowExecutionMetricSeries(namespace, uid string, opts *MetricSeriesOptions) ([]*MetricSeries, error) {
+	if opts == nil {
+		opts = &MetricSeriesOptions{}
+	}
+
+	maxPoints := opts.MaxPoints
+	if maxPoints <= 0 {
+		maxPoints = defaultMetricSeriesPoints
+	}
+	if maxPoints > maxMetricSeriesPoints {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Series can have at most %v points.", maxMetricSeriesPoints))
+	}
+
+	id, err := c.getWorkflowExecutionID(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	// The subquery keeps the default ? placeholders, the outer query replaces all of them
+	buckets := sq.Select("node_id", "name", "step", "value", "created_at").
+		Column("ntile(?) OVER (PARTITION BY node_id, name ORDER BY step) bucket", maxPoints).
+		From("workflow_execution_metrics").
+		Where(sq.Eq{"workflow_execution_id": id})
+	if opts.NodeID != "" {
+		buckets = buckets.Where(sq.Eq{"node_id": opts.NodeID})
+	}
+	if len(opts.Names) > 0 {
+		buckets = buckets.Where(sq.Eq{"name": opts.Names})
+	}
+
+	query := sb.Select("node_id", "name", "MAX(step) step", "AVG(value) \"value\"", "MIN(value) \"min\"", "MAX(value) \"max\"", "MAX(created_at) created_at").
+		FromSelect(buckets, "b").
+		GroupBy("node_id", "name", "bucket").
+		OrderBy("node_id", "name", "step")
+
+	rows := make([]*metricSeriesRow, 0)
+	if err := c.DB.Selectx(&rows, query); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to get metric series.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to get metric series.")
+	}
+
+	return groupMetricSeries(rows), nil
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMetricPoints(t *testing.T) {
+	assert.Nil(t, validateMetricPoints([]*MetricPoint{{Name: "loss", Step: 1, Value: 0.5}}))
+
+	assert.NotNil(t, validateMetricPoints(nil))
+	assert.NotNil(t, validateMetricPoints([]*MetricPoint{{Name: "", Step: 1, Value: 0.5}}))
+	assert.NotNil(t, validateMetricPoints([]*MetricPoint{{Name: "loss", Step: 1, Value: math.NaN()}}))
+	assert.NotNil(t, validateMetricPoints([]*MetricPoint{{Name: "loss", Step: 1, Value: math.Inf(1)}}))
+	assert.NotNil(t, validateMetricPoints(make([]*MetricPoint, maxLoggedMetricPoints+1)))
+}
+
+func TestGroupMetricSeries(t *testing.T) {
+	row := func(nodeID, name string, step int64, value float64) *metricSeriesRow {
+		return &metricSeriesRow{
+			NodeID:            nodeID,
+			Name:              name,
+			MetricSeriesPoint: MetricSeriesPoint{Step: step, Value: value, Min: value, Max: value},
+		}
+	}
+
+	series := groupMetricSeries([]*metricSeriesRow{
+		row("node-1", "accuracy", 1, 0.5),
+		row("node-1", "accuracy", 2, 0.7),
+		row("node-1", "loss", 1, 2.0),
+		row("node-2", "loss", 1, 1.5),
+	})
+
+	assert.Len(t, series, 3)
+	assert.Equal(t, "node-1", series[0].NodeID)
+	assert.Equal(t, "accuracy", series[0].Name)
+	assert.Len(t, series[0].Points, 2)
+	assert.Equal(t, int64(2), series[0].Points[1].Step)
+	assert.Equal(t, 0.7, series[0].Points[1].Value)
+	assert.Equal(t, "loss", series[1].Name)
+	assert.Equal(t, "node-2", series[2].NodeID)
+
+	assert.Empty(t, groupMetricSeries(nil))
+}
@@ -0,0 +1,45 @@
+package v1
+
+import "time"
+
+// MetricPoint is the value of a metric at a step, logged by a running step of a workflow execution
+type MetricPoint struct {
+	Name  string
+	Step  int64
+	Value float64
+}
+
+// MetricSeriesOptions selects the metric series of a workflow execution
+type MetricSeriesOptions struct {
+	// NodeID limits the series to the ones logged by the node, all nodes if empty
+	NodeID string
+	// Names limits the series to the metrics with these names, all metrics if empty
+	Names []string
+	// MaxPoints is the number of points each series is downsampled to, if it has more
+	MaxPoints int
+}
+
+// MetricSeries are the values of a metric logged by a node of a workflow execution, ordered by step
+type MetricSeries struct {
+	NodeID string
+	Name   string
+	Points []*MetricSeriesPoint
+}
+
+// MetricSeriesPoint is a value of a metric series.
+// If the series is downsampled, a point is the mean of consecutive values, up to and including Step,
+// and Min and Max are the range of those values. Otherwise Value, Min and Max are the same.
+type MetricSeriesPoint struct {
+	Step      int64
+	Value     float64
+	Min       float64
+	Max       float64
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// metricSeriesRow is a point of a metric series as it is queried
+type metricSeriesRow struct {
+	NodeID string `db:"node_id"`
+	Name   string
+	MetricSeriesPoint
+}
@@ -0,0 +1,478 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	// executionQueueLockID is the postgres advisory lock that makes admission to the execution queue one at a time,
+	// across every server that uses the database
+	executionQueueLockID = 5128903345
+	// ExecutionQueueDispatchInterval is how often queued workflow executions are checked for free slots
+	ExecutionQueueDispatchInterval = 5 * time.Second
+	// MaxWorkflowExecutionPriority is the highest priority of a queued workflow execution
+	MaxWorkflowExecutionPriority = 1000
+	// MinWorkflowExecutionPriority is the lowest priority of a queued workflow execution
+	MinWorkflowExecutionPriority = -1000
+)
+
+// activeWorkflowExecutionPhases are the phases of workflow executions that count towards the execution queue limits
+var activeWorkflowExecutionPhases = []string{string(wfv1.NodePending), string(wfv1.NodeRunning), string(WorkflowExecutionSuspended)}
+
+// queueEntry is a workflow execution as the execution queue sees it
+type queueEntry struct {
+	ID             uint64
+	UID            string
+	Namespace      string
+	TemplateUID    string `db:"template_uid"`
+	Labels         types.JSONLabels
+	QueuedManifest sql.NullString `db:"queued_manifest"`
+}
+
+// executionQueueCounts are the number of active workflow executions for each execution queue limit
+type executionQueueCounts struct {
+	namespaces map[string]int
+	templates  map[string]int
+	labels     map[string]int
+}
+
+func newExecutionQueueCounts() *executionQueueCounts {
+	return &executionQueueCounts{
+		namespaces: make(map[string]int),
+		templates:  make(map[string]int),
+		labels:     make(map[string]int),
+	}
+}
+
+func templateCountKey(entry *queueEntry) string {
+	return entry.Namespace + "/" + entry.TemplateUID
+}
+
+func labelCountKey(entry *queueEntry, key string) string {
+	return entry.Namespace + "/" + key + "=" + entry.Labels[key]
+}
+
+// add counts the workflow execution as active
+func (c *executionQueueCounts) add(entry *queueEntry) {
+	c.namespaces[entry.Namespace]++
+	c.templates[templateCountKey(entry)]++
+	for key := range entry.Labels {
+		c.labels[labelCountKey(entry, key)]++
+	}
+}
+
+// admits returns true if the workflow execution can run without going over a limit
+func (config *ExecutionQueueConfig) admits(counts *executionQueueCounts, entry *queueEntry) bool {
+	if config.NamespaceLimit > 0 && counts.namespaces[entry.Namespace] >= config.NamespaceLimit {
+		return false
+	}
+
+	if config.TemplateLimit > 0 && counts.templates[templateCountKey(entry)] >= config.TemplateLimit {
+		return false
+	}
+
+	for _, labelLimit := range config.LabelLimits {
+		value, ok := entry.Labels[labelLimit.Key]
+		if !ok || labelLimit.Limit <= 0 || (labelLimit.Value != "" && labelLimit.Value != value) {
+			continue
+		}
+
+		if counts.labels[labelCountKey(entry, labelLimit.Key)] >= labelLimit.Limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getExecutionQueueConfig returns the execution queue configuration, or nil if executions are never queued
+func (c *Client) getExecutionQueueConfig() (*ExecutionQueueConfig, error) {
+	sysConfig, err := c.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := sysConfig.ExecutionQueue()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Error": err.Error(),
+		}).Error("Invalid executionQueue configuration.")
+		return nil, util.NewUserError(codes.FailedPrecondition, "Invalid executionQueue configuration.")
+	}
+
+	return config, nil
+}
+
+// withExecutionQueueLock runs f while holding the execution queue lock
+func (c *Client) withExecutionQueueLock(f func() error) error {
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock($1)", executionQueueLockID); err != nil {
+		return err
+	}
+
+	if err := f(); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func queueEntriesSelectBuilder() sq.SelectBuilder {
+	return sb.Select("we.id", "we.uid", "we.namespace", `wt.uid "template_uid"`, "we.labels").
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON wtv.id = we.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"we.is_archived": false,
+			"wt.is_system":   false,
+		})
+}
+
+// getExecutionQueueCounts counts the active workflow executions, in namespace if it is not empty
+func (c *Client) getExecutionQueueCounts(namespace string) (*executionQueueCounts, error) {
+	query := queueEntriesSelectBuilder().
+		Where(sq.Eq{
+			"we.phase":       activeWorkflowExecutionPhases,
+			"we.finished_at": nil,
+		})
+	if namespace != "" {
+		query = query.Where(sq.Eq{"we.namespace": namespace})
+	}
+
+	var entries []*queueEntry
+	if err := c.DB.Selectx(&entries, query); err != nil {
+		return nil, err
+	}
+
+	counts := newExecutionQueueCounts()
+	for _, entry := range entries {
+		counts.add(entry)
+	}
+
+	return counts, nil
+}
+
+// admitsAfter returns true if the workflow execution can run once the queued workflow executions ahead of it were dispatched.
+// ahead are in dispatch order. Like DispatchQueuedWorkflowExecutions, an entry that is over a limit does not take a slot,
+// so it only holds back the workflow execution if they share the limit.
+func (config *ExecutionQueueConfig) admitsAfter(counts *executionQueueCounts, ahead []*queueEntry, entry *queueEntry) bool {
+	for _, queued := range ahead {
+		if config.admits(counts, queued) {
+			counts.add(queued)
+		}
+	}
+
+	return config.admits(counts, entry)
+}
+
+// clampWorkflowExecutionPriority returns the priority within MinWorkflowExecutionPriority and MaxWorkflowExecutionPriority
+func clampWorkflowExecutionPriority(priority int32) int32 {
+	if priority > MaxWorkflowExecutionPriority {
+		return MaxWorkflowExecutionPriority
+	}
+	if priority < MinWorkflowExecutionPriority {
+		return MinWorkflowExecutionPriority
+	}
+
+	return priority
+}
+
+// admitWorkflow returns true if the workflow can be submitted now, it is queued if it would go over a limit.
+// The queued workflow executions of the namespace with the same or a higher priority are dispatched first,
+// so they count towards the limits if they fit in them.
+// The execution queue lock must be held.
+func (c *Client) admitWorkflow(namespace string, wf *wfv1.Workflow, labels types.JSONLabels, priority int32, config *ExecutionQueueConfig) (bool, error) {
+	query := queueEntriesSelectBuilder().
+		Where(sq.Eq{
+			"we.namespace": namespace,
+			"we.phase":     WorkflowExecutionQueued,
+		}).
+		Where(sq.GtOrEq{"we.priority": priority}).
+		OrderBy("we.priority DESC", "we.queued_at", "we.id")
+
+	var ahead []*queueEntry
+	if err := c.DB.Selectx(&ahead, query); err != nil {
+		return false, err
+	}
+
+	counts, err := c.getExecutionQueueCounts(namespace)
+	if err != nil {
+		return false, err
+	}
+
+	return config.admitsAfter(counts, ahead, &queueEntry{
+		Namespace:   namespace,
+		TemplateUID: wf.ObjectMeta.Labels[workflowTemplateUIDLabelKey],
+		Labels:      labels,
+	}), nil
+}
+
+// createWorkflowWithQueue submits the workflow if the execution queue limits allow it, and queues it otherwise
+func (c *Client) createWorkflowWithQueue(namespace string, workflowTemplateVersionID uint64, wf *wfv1.Workflow, opts *WorkflowExecutionOptions, labels types.JSONLabels, config *ExecutionQueueConfig) (createdWorkflow *WorkflowExecution, err error) {
+	opts.Priority = clampWorkflowExecutionPriority(opts.Priority)
+	err = c.withExecutionQueueLock(func() error {
+		admitted, err := c.admitWorkflow(namespace, wf, labels, opts.Priority, config)
+		if err != nil {
+			return err
+		}
+
+		if admitted {
+			createdWorkflow, err = c.submitWorkflow(namespace, workflowTemplateVersionID, wf, opts, labels)
+		} else {
+			createdWorkflow, err = c.enqueueWorkflow(namespace, workflowTemplateVersionID, wf, opts, labels)
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if createdWorkflow.Phase == WorkflowExecutionQueued {
+		createdWorkflow.QueuePosition, err = c.getWorkflowExecutionQueuePosition(namespace, createdWorkflow)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// enqueueWorkflow stores the workflow in the Queued phase, to be submitted by DispatchQueuedWorkflowExecutions
+func (c *Client) enqueueWorkflow(namespace string, workflowTemplateVersionID uint64, wf *wfv1.Workflow, opts *WorkflowExecutionOptions, labels types.JSONLabels) (*WorkflowExecution, error) {
+	// The name is the uid of the execution, so it has to be known before argo creates the workflow
+	if wf.ObjectMeta.Name == "" {
+		wf.ObjectMeta.Name = wf.ObjectMeta.GenerateName + rand.String(5)
+		wf.ObjectMeta.GenerateName = ""
+	}
+
+	manifest, err := json.Marshal(wf)
+	if err != nil {
+		return nil, err
+	}
+
+	queuedWorkflow := &WorkflowExecution{
+		Name:      wf.ObjectMeta.Name,
+		CreatedAt: time.Now().UTC(),
+		Phase:     WorkflowExecutionQueued,
+		Priority:  opts.Priority,
+		WorkflowTemplate: &WorkflowTemplate{
+			WorkflowTemplateVersionID: workflowTemplateVersionID,
+		},
+		Parameters: opts.Parameters,
+		Labels:     labels,
+	}
+	if err := queuedWorkflow.GenerateUID(wf.ObjectMeta.Name); err != nil {
+		return nil, err
+	}
+
+	if err := c.createWorkflowExecutionDB(namespace, queuedWorkflow, string(manifest)); err != nil {
+		return nil, err
+	}
+
+	queuedWorkflow.QueuedAt = &queuedWorkflow.CreatedAt
+
+	return queuedWorkflow, nil
+}
+
+// getWorkflowExecutionQueuePosition returns the 1-based position of the queued workflow execution in its namespace
+func (c *Client) getWorkflowExecutionQueuePosition(namespace string, workflow *WorkflowExecution) (int, error) {
+	if workflow.QueuedAt == nil {
+		return 0, nil
+	}
+
+	ahead := 0
+	err := sb.Select("COUNT(*)").
+		From("workflow_executions").
+		Where(sq.Eq{
+			"namespace":   namespace,
+			"phase":       WorkflowExecutionQueued,
+			"is_archived": false,
+		}).
+		Where(sq.Or{
+			sq.Gt{"priority": workflow.Priority},
+			sq.And{
+				sq.Eq{"priority": workflow.Priority},
+				sq.Or{
+					sq.Lt{"queued_at": workflow.QueuedAt},
+					sq.And{
+						sq.Eq{"queued_at": workflow.QueuedAt},
+						sq.Lt{"id": workflow.ID},
+					},
+				},
+			},
+		}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&ahead)
+	if err != nil {
+		return 0, err
+	}
+
+	return ahead + 1, nil
+}
+
+// getQueuedWorkflow returns the argo workflow that is submitted when the workflow execution is dispatched
+func (c *Client) getQueuedWorkflow(namespace, uid string) (*wfv1.Workflow, error) {
+	manifest := sql.NullString{}
+	err := sb.Select("queued_manifest").
+		From("workflow_executions").
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	wf := &wfv1.Workflow{}
+	if err := json.Unmarshal([]byte(manifest.String), wf); err != nil {
+		return nil, err
+	}
+
+	return wf, nil
+}
+
+// ListQueuedWorkflowExecutions returns the queued workflow executions of the namespace in the order they are dispatched
+func (c *Client) ListQueuedWorkflowExecutions(namespace string) (workflows []*WorkflowExecution, err error) {
+	query := workflowExecutionsSelectBuilder(namespace, "", "", false).
+		Where(sq.Eq{"we.phase": WorkflowExecutionQueued}).
+		OrderBy("we.priority DESC", "we.queued_at", "we.id")
+
+	if err := c.DB.Selectx(&workflows, query); err != nil {
+		return nil, err
+	}
+
+	for i, workflow := range workflows {
+		workflow.QueuePosition = i + 1
+	}
+
+	return
+}
+
+// cancelQueuedWorkflowExecution marks the workflow execution as Terminated if it is queued.
+// It returns false if the workflow execution is not queued.
+func (c *Client) cancelQueuedWorkflowExecution(namespace, uid string) (cancelled bool, err error) {
+	err = c.withExecutionQueueLock(func() error {
+		result, err := sb.Update("workflow_executions").
+			SetMap(sq.Eq{
+				"phase":           "Terminated",
+				"finished_at":     time.Now().UTC(),
+				"queued_manifest": nil,
+			}).
+			Where(sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+				"phase":     WorkflowExecutionQueued,
+			}).
+			RunWith(c.DB).
+			Exec()
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		cancelled = rowsAffected > 0
+
+		return err
+	})
+
+	return
+}
+
+// DispatchQueuedWorkflowExecutions submits queued workflow executions to argo while the execution queue limits allow.
+// Higher priorities are dispatched first, then the oldest. A queued workflow execution that is over a limit
+// does not hold back the ones after it that are not.
+// If the execution queue is not configured, every queued workflow execution is dispatched.
+func (c *Client) DispatchQueuedWorkflowExecutions() error {
+	config, err := c.getExecutionQueueConfig()
+	if err != nil {
+		return err
+	}
+
+	return c.withExecutionQueueLock(func() error {
+		query := queueEntriesSelectBuilder().
+			Columns("we.queued_manifest").
+			Where(sq.Eq{"we.phase": WorkflowExecutionQueued}).
+			OrderBy("we.priority DESC", "we.queued_at", "we.id")
+
+		var entries []*queueEntry
+		if err := c.DB.Selectx(&entries, query); err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		counts, err := c.getExecutionQueueCounts("")
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if config != nil && !config.admits(counts, entry) {
+				continue
+			}
+
+			if err := c.dispatchWorkflowExecution(entry); err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": entry.Namespace,
+					"UID":       entry.UID,
+					"Error":     err.Error(),
+				}).Error("Unable to dispatch queued workflow execution.")
+				continue
+			}
+
+			counts.add(entry)
+		}
+
+		return nil
+	})
+}
+
+// dispatchWorkflowExecution submits the queued workflow to argo and marks the workflow execution as Pending
+func (c *Client) dispatchWorkflowExecution(entry *queueEntry) error {
+	wf := &wfv1.Workflow{}
+	if err := json.Unmarshal([]byte(entry.QueuedManifest.String), wf); err != nil {
+		return err
+	}
+
+	// The workflow exists if a previous dispatch created it, but could not update the database
+	_, err := c.ArgoprojV1alpha1().Workflows(entry.Namespace).Create(wf)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	_, err = sb.Update("workflow_executions").
+		SetMap(sq.Eq{
+			"phase":           wfv1.NodePending,
+			"queued_manifest": nil,
+		}).
+		Where(sq.Eq{
+			"id":    entry.ID,
+			"phase": WorkflowExecutionQueued,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecutionQueueConfig_Admits(t *testing.T) {
+	config := &ExecutionQueueConfig{
+		NamespaceLimit: 4,
+		TemplateLimit:  2,
+		LabelLimits: []ExecutionQueueLabelLimit{
+			{Key: "team", Limit: 1},
+			{Key: "gpu", Value: "true", Limit: 1},
+		},
+	}
+
+	entry := func(templateUID string, labels map[string]string) *queueEntry {
+		return &queueEntry{Namespace: "onepanel", TemplateUID: templateUID, Labels: labels}
+	}
+
+	counts := newExecutionQueueCounts()
+	assert.True(t, config.admits(counts, entry("train", nil)))
+
+	counts.add(entry("train", nil))
+	counts.add(entry("train", map[string]string{"team": "vision"}))
+	// Template limit
+	assert.False(t, config.admits(counts, entry("train", nil)))
+	assert.True(t, config.admits(counts, entry("evaluate", nil)))
+	// Label limit for each value of the label
+	assert.False(t, config.admits(counts, entry("evaluate", map[string]string{"team": "vision"})))
+	assert.True(t, config.admits(counts, entry("evaluate", map[string]string{"team": "nlp"})))
+
+	// Label limit for one value of the label
+	counts.add(entry("evaluate", map[string]string{"gpu": "true"}))
+	assert.False(t, config.admits(counts, entry("other", map[string]string{"gpu": "true"})))
+	assert.True(t, config.admits(counts, entry("other", map[string]string{"gpu": "false"})))
+
+	// Namespace limit, other namespaces have their own
+	counts.add(entry("other", nil))
+	assert.False(t, config.admits(counts, entry("other", nil)))
+	assert.True(t, config.admits(counts, &queueEntry{Namespace: "other", TemplateUID: "train"}))
+}
+
+func TestExecutionQueueConfig_AdmitsAfter(t *testing.T) {
+	config := &ExecutionQueueConfig{
+		NamespaceLimit: 3,
+		TemplateLimit:  1,
+	}
+
+	entry := func(templateUID string) *queueEntry {
+		return &queueEntry{Namespace: "onepanel", TemplateUID: templateUID}
+	}
+
+	counts := newExecutionQueueCounts()
+	counts.add(entry("train"))
+	// A queued execution blocked by its template limit doesn't hold back other templates
+	assert.True(t, config.admitsAfter(counts, []*queueEntry{entry("train")}, entry("evaluate")))
+
+	counts = newExecutionQueueCounts()
+	counts.add(entry("train"))
+	// A queued execution that fits takes the slot first
+	assert.False(t, config.admitsAfter(counts, []*queueEntry{entry("evaluate")}, entry("evaluate")))
+
+	counts = newExecutionQueueCounts()
+	counts.add(entry("train"))
+	// Queued executions that fit count towards the namespace limit
+	assert.False(t, config.admitsAfter(counts, []*queueEntry{entry("evaluate"), entry("test")}, entry("export")))
+}
+
+func TestClampWorkflowExecutionPriority(t *testing.T) {
+	assert.Equal(t, int32(5), clampWorkflowExecutionPriority(5))
+	assert.Equal(t, int32(MaxWorkflowExecutionPriority), clampWorkflowExecutionPriority(1<<30))
+	assert.Equal(t, int32(MinWorkflowExecutionPriority), clampWorkflowExecutionPriority(-1<<30))
+}
+
+func TestSystemConfig_ExecutionQueue(t *testing.T) {
+	config, err := SystemConfig{}.ExecutionQueue()
+	assert.Nil(t, err)
+	assert.Nil(t, config)
+
+	config, err = SystemConfig{"executionQueue": `
+namespaceLimit: 10
+labelLimits:
+- key: team
+  limit: 2
+`}.ExecutionQueue()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, config.NamespaceLimit)
+	assert.Equal(t, 0, config.TemplateLimit)
+	assert.Equal(t, "team", config.LabelLimits[0].Key)
+
+	_, err = SystemConfig{"executionQueue": `
+labelLimits:
+- limit: 2
+`}.ExecutionQueue()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,225 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// retentionLockID is the postgres advisory lock held while the retention policies are applied,
+	// so only one server applies them at a time
+	retentionLockID = 5128903346
+	// RetentionInterval is how often the retention policies are applied
+	RetentionInterval = time.Hour
+)
+
+// expired returns the candidates the policy archives at now, with the reason for each of them.
+// candidates must be sorted newest first, as the newest executions of each template may be kept.
+func (policy *RetentionPolicy) expired(candidates []*retentionCandidate, now time.Time) (expired []*retentionCandidate, reasons []string) {
+	phases := make(map[string]bool)
+	for _, phase := range policy.Phases {
+		phases[phase] = true
+	}
+	maxAge := time.Duration(policy.ArchiveAfterDays) * 24 * time.Hour
+
+	newer := make(map[string]int)
+	for _, candidate := range candidates {
+		if policy.Namespace != "" && candidate.Namespace != policy.Namespace {
+			continue
+		}
+		if len(phases) > 0 && !phases[string(candidate.Phase)] {
+			continue
+		}
+
+		templateKey := candidate.Namespace + "/" + candidate.WorkflowTemplateUID
+		position := newer[templateKey]
+		newer[templateKey]++
+
+		if policy.KeepLast > 0 && position < policy.KeepLast {
+			continue
+		}
+		if maxAge > 0 && now.Sub(candidate.FinishedAt) < maxAge {
+			continue
+		}
+		if policy.keeps(candidate) {
+			continue
+		}
+
+		var reason []string
+		if maxAge > 0 {
+			reason = append(reason, fmt.Sprintf("Finished more than %v days ago.", policy.ArchiveAfterDays))
+		}
+		if policy.KeepLast > 0 {
+			reason = append(reason, fmt.Sprintf("Not one of the %v newest executions of its workflow template.", policy.KeepLast))
+		}
+
+		expired = append(expired, candidate)
+		reasons = append(reasons, strings.Join(reason, " "))
+	}
+
+	return
+}
+
+// keeps returns true if the labels of the candidate exempt it from the policy
+func (policy *RetentionPolicy) keeps(candidate *retentionCandidate) bool {
+	if policy.KeepLabeled && len(candidate.Labels) > 0 {
+		return true
+	}
+
+	for _, key := range policy.KeepLabels {
+		if _, ok := candidate.Labels[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyRetentionPolicies returns the candidates that any of the policies archive, in the order of candidates.
+// A candidate archived by more than one policy is reported for the first one.
+func applyRetentionPolicies(policies []RetentionPolicy, candidates []*retentionCandidate, now time.Time) []*RetentionReportItem {
+	items := make(map[*retentionCandidate]*RetentionReportItem)
+	for i := range policies {
+		expired, reasons := policies[i].expired(candidates, now)
+		for j, candidate := range expired {
+			if _, ok := items[candidate]; ok {
+				continue
+			}
+
+			items[candidate] = &RetentionReportItem{
+				UID:                 candidate.UID,
+				Name:                candidate.Name,
+				Namespace:           candidate.Namespace,
+				WorkflowTemplateUID: candidate.WorkflowTemplateUID,
+				Phase:               candidate.Phase,
+				CreatedAt:           candidate.CreatedAt,
+				FinishedAt:          candidate.FinishedAt,
+				Policy:              i,
+				Reason:              reasons[j],
+			}
+		}
+	}
+
+	result := make([]*RetentionReportItem, 0, len(items))
+	for _, candidate := range candidates {
+		if item, ok := items[candidate]; ok {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// getRetentionPolicies returns the retention policies, or nil if there are none
+func (c *Client) getRetentionPolicies() ([]RetentionPolicy, error) {
+	sysConfig, err := c.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := sysConfig.RetentionPolicies()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Error": err.Error(),
+		}).Error("Invalid retentionPolicies configuration.")
+		return nil, util.NewUserError(codes.FailedPrecondition, "Invalid retentionPolicies configuration.")
+	}
+
+	return policies, nil
+}
+
+// listRetentionCandidates returns the finished workflow executions that are not archived, newest first.
+// System workflow executions are managed by the resources that create them, so they are not candidates.
+// If namespace is empty, the executions of every namespace are returned.
+func (c *Client) listRetentionCandidates(namespace string) (candidates []*retentionCandidate, err error) {
+	query := sb.Select("we.uid", "we.name", "we.namespace", `wt.uid "template_uid"`, "we.phase", "we.labels", "we.created_at", "we.finished_at").
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON wtv.id = we.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"we.is_archived": false,
+			"wt.is_system":   false,
+		}).
+		Where(sq.NotEq{"we.finished_at": nil}).
+		OrderBy("we.created_at DESC")
+
+	if namespace != "" {
+		query = query.Where(sq.Eq{"we.namespace": namespace})
+	}
+
+	err = c.DB.Selectx(&candidates, query)
+
+	return
+}
+
+// GetRetentionReport returns the workflow executions of the namespace that the retention policies would archive now.
+// Nothing is changed, this lets admins preview the effect of the policies.
+func (c *Client) GetRetentionReport(namespace string) ([]*RetentionReportItem, error) {
+	policies, err := c.getRetentionPolicies()
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return []*RetentionReportItem{}, nil
+	}
+
+	candidates, err := c.listRetentionCandidates(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyRetentionPolicies(policies, candidates, time.Now().UTC()), nil
+}
+
+// ApplyRetentionPolicies archives the workflow executions of every namespace that the retention policies select.
+// If another server is applying them, it returns without doing anything.
+func (c *Client) ApplyRetentionPolicies() error {
+	policies, err := c.getRetentionPolicies()
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	locked := false
+	if err := tx.QueryRow("SELECT pg_try_advisory_xact_lock($1)", retentionLockID).Scan(&locked); err != nil {
+		return err
+	}
+	if !locked {
+		return nil
+	}
+
+	candidates, err := c.listRetentionCandidates("")
+	if err != nil {
+		return err
+	}
+
+	for _, item := range applyRetentionPolicies(policies, candidates, time.Now().UTC()) {
+		if err := c.ArchiveWorkflowExecution(item.Namespace, item.UID); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": item.Namespace,
+				"UID":       item.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to archive workflow execution for retention policy.")
+			continue
+		}
+
+		log.WithFields(log.Fields{
+			"Namespace": item.Namespace,
+			"UID":       item.UID,
+			"Policy":    item.Policy,
+		}).Info("Archived workflow execution for retention policy.")
+	}
+
+	return tx.Commit()
+}
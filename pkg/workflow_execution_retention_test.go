@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRetentionPolicies(t *testing.T) {
+	now := time.Date(2020, 10, 30, 0, 0, 0, 0, time.UTC)
+	candidate := func(uid, namespace, templateUID string, phase wfv1.NodePhase, age int, labels map[string]string) *retentionCandidate {
+		return &retentionCandidate{
+			UID:                 uid,
+			Namespace:           namespace,
+			WorkflowTemplateUID: templateUID,
+			Phase:               phase,
+			Labels:              labels,
+			FinishedAt:          now.Add(-time.Duration(age) * 24 * time.Hour),
+		}
+	}
+
+	// Newest first
+	candidates := []*retentionCandidate{
+		candidate("a1", "onepanel", "a", wfv1.NodeSucceeded, 1, nil),
+		candidate("a2", "onepanel", "a", wfv1.NodeSucceeded, 40, nil),
+		candidate("a3", "onepanel", "a", wfv1.NodeFailed, 50, nil),
+		candidate("a4", "onepanel", "a", wfv1.NodeSucceeded, 60, map[string]string{"keep": "true"}),
+		candidate("b1", "onepanel", "b", wfv1.NodeSucceeded, 45, nil),
+		candidate("c1", "other", "c", wfv1.NodeFailed, 2, nil),
+		candidate("c2", "other", "c", wfv1.NodeFailed, 3, nil),
+	}
+
+	uids := func(policies []RetentionPolicy) []string {
+		result := make([]string, 0)
+		for _, item := range applyRetentionPolicies(policies, candidates, now) {
+			result = append(result, item.UID)
+		}
+		return result
+	}
+
+	// Succeeded executions are archived after 30 days, except labeled ones
+	assert.Equal(t, []string{"a2", "b1"}, uids([]RetentionPolicy{
+		{Namespace: "onepanel", Phases: []string{"Succeeded"}, ArchiveAfterDays: 30, KeepLabels: []string{"keep"}},
+	}))
+
+	// The newest execution of each template is kept, in every namespace
+	assert.Equal(t, []string{"a2", "a3", "a4", "c2"}, uids([]RetentionPolicy{
+		{KeepLast: 1},
+	}))
+
+	// Both limits apply, and labeled executions are kept
+	assert.Equal(t, []string{"a3"}, uids([]RetentionPolicy{
+		{ArchiveAfterDays: 30, KeepLast: 2, KeepLabeled: true},
+	}))
+
+	// An execution archived by several policies is reported once, for the first policy
+	items := applyRetentionPolicies([]RetentionPolicy{
+		{Namespace: "other", KeepLast: 1},
+		{KeepLast: 1},
+	}, candidates, now)
+	assert.Len(t, items, 4)
+	assert.Equal(t, "c2", items[3].UID)
+	assert.Equal(t, "other", items[3].Namespace)
+	assert.Equal(t, 0, items[3].Policy)
+	assert.Equal(t, 1, items[0].Policy)
+}
+
+func TestSystemConfig_RetentionPolicies(t *testing.T) {
+	policies, err := SystemConfig{}.RetentionPolicies()
+	assert.Nil(t, err)
+	assert.Nil(t, policies)
+
+	policies, err = SystemConfig{"retentionPolicies": `
+- namespace: onepanel
+  phases:
+  - Succeeded
+  archiveAfterDays: 30
+  keepLabels:
+  - keep
+- keepLast: 10
+`}.RetentionPolicies()
+	assert.Nil(t, err)
+	assert.Len(t, policies, 2)
+	assert.Equal(t, 30, policies[0].ArchiveAfterDays)
+	assert.Equal(t, []string{"keep"}, policies[0].KeepLabels)
+	assert.Equal(t, 10, policies[1].KeepLast)
+
+	_, err = SystemConfig{"retentionPolicies": `
+- namespace: onepanel
+`}.RetentionPolicies()
+	assert.NotNil(t, err)
+}
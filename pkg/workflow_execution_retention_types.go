@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/types"
+)
+
+// retentionCandidate is a finished workflow execution as the retention policies see it
+type retentionCandidate struct {
+	UID                 string
+	Name                string
+	Namespace           string
+	WorkflowTemplateUID string `db:"template_uid"`
+	Phase               wfv1.NodePhase
+	Labels              types.JSONLabels
+	CreatedAt           time.Time `db:"created_at"`
+	FinishedAt          time.Time `db:"finished_at"`
+}
+
+// RetentionReportItem is a workflow execution that the retention policies archive.
+// Policy is the index of the first policy in the retentionPolicies configuration that archives it.
+type RetentionReportItem struct {
+	UID                 string
+	Name                string
+	Namespace           string
+	WorkflowTemplateUID string
+	Phase               wfv1.NodePhase
+	CreatedAt           time.Time
+	FinishedAt          time.Time
+	Policy              int
+	Reason              string
+}
@@ -0,0 +1,181 @@
+package v1
+
+import (
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	argoerrors "github.com/argoproj/argo/errors"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RetryWorkflowExecutionOptions control which nodes of a workflow execution are run again
+type RetryWorkflowExecutionOptions struct {
+	// RestartNodeID is the node to restart from. The node and every node after it run again, along with failed nodes.
+	// If empty, only the failed nodes run again.
+	RestartNodeID string
+}
+
+// updateWorkflowExecutionPhase sets the phase of the workflow execution in the database.
+// finished_at is cleared for phases where the workflow execution runs again.
+func (c *Client) updateWorkflowExecutionPhase(namespace, uid string, phase wfv1.NodePhase) error {
+	fieldMap := sq.Eq{
+		"phase": phase,
+	}
+	if phase == wfv1.NodeRunning {
+		fieldMap["finished_at"] = nil
+	}
+
+	_, err := sb.Update("workflow_executions").
+		SetMap(fieldMap).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// workflowTransitionError converts the errors of argo workflow transitions to user errors
+func workflowTransitionError(namespace, uid string, err error) error {
+	if errors.IsNotFound(err) {
+		return util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+	if argoErr, ok := err.(argoerrors.ArgoError); ok && argoErr.Code() == argoerrors.CodeBadRequest {
+		return util.NewUserError(codes.FailedPrecondition, argoErr.Message())
+	}
+	if _, ok := err.(*util.UserError); ok {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"Namespace": namespace,
+		"UID":       uid,
+		"Error":     err.Error(),
+	}).Error("Unable to update workflow.")
+
+	return util.NewUserError(codes.Unknown, "Unable to update workflow.")
+}
+
+// nodeDescendants returns the ids of the nodes and every node reachable through their children
+func nodeDescendants(nodes map[string]wfv1.NodeStatus, ids ...string) map[string]bool {
+	descendants := make(map[string]bool)
+	for len(ids) > 0 {
+		id := ids[0]
+		ids = ids[1:]
+		if descendants[id] {
+			continue
+		}
+
+		descendants[id] = true
+		ids = append(ids, nodes[id].Children...)
+	}
+
+	return descendants
+}
+
+// formulateRestartWorkflow returns the workflow with the node, every node after it, and the failed nodes removed,
+// so argo runs them again. Steps, groups and DAGs that lead to a removed node are set back to Running.
+// The pods of the removed nodes are returned so they can be deleted.
+func formulateRestartWorkflow(wf *wfv1.Workflow, nodeID string) (*wfv1.Workflow, []string, error) {
+	if !wf.Status.Phase.Completed() {
+		return nil, nil, util.NewUserError(codes.FailedPrecondition, "Workflow must be completed to restart it from a node.")
+	}
+	if _, ok := wf.Status.Nodes[nodeID]; !ok {
+		return nil, nil, util.NewUserError(codes.NotFound, "Node not found.")
+	}
+
+	onExitNodeName := wf.ObjectMeta.Name + ".onExit"
+	restartIDs := []string{nodeID}
+	for id, node := range wf.Status.Nodes {
+		if strings.HasPrefix(node.Name, onExitNodeName) {
+			restartIDs = append(restartIDs, id)
+			continue
+		}
+		if (node.Phase == wfv1.NodeFailed || node.Phase == wfv1.NodeError) && node.Type != wfv1.NodeTypeDAG && id != wf.ObjectMeta.Name {
+			restartIDs = append(restartIDs, id)
+		}
+	}
+	removed := nodeDescendants(wf.Status.Nodes, restartIDs...)
+	// The root node is kept so argo continues the workflow instead of starting a new one
+	delete(removed, wf.ObjectMeta.Name)
+
+	newWf := wf.DeepCopy()
+	delete(newWf.ObjectMeta.Labels, common.LabelKeyCompleted)
+	if newWf.ObjectMeta.Labels != nil {
+		newWf.ObjectMeta.Labels[common.LabelKeyPhase] = string(wfv1.NodeRunning)
+	}
+	newWf.Status.Conditions.UpsertCondition(wfv1.WorkflowCondition{Status: metav1.ConditionFalse, Type: wfv1.WorkflowConditionCompleted})
+	newWf.Status.Phase = wfv1.NodeRunning
+	newWf.Status.Message = ""
+	newWf.Status.FinishedAt = metav1.Time{}
+	// Terminated workflows have a deadline of 0 and a shutdown strategy, both would stop the workflow again
+	if newWf.Spec.ActiveDeadlineSeconds != nil && *newWf.Spec.ActiveDeadlineSeconds == 0 {
+		newWf.Spec.ActiveDeadlineSeconds = nil
+	}
+	newWf.Spec.Shutdown = ""
+
+	var podNames []string
+	newWf.Status.Nodes = make(map[string]wfv1.NodeStatus)
+	for id, node := range wf.Status.Nodes {
+		if removed[id] {
+			if node.Type == wfv1.NodeTypePod {
+				podNames = append(podNames, id)
+			}
+			continue
+		}
+
+		newNode := node.DeepCopy()
+		children := make([]string, 0, len(node.Children))
+		for _, child := range node.Children {
+			if !removed[child] {
+				children = append(children, child)
+			}
+		}
+		newNode.Children = children
+
+		// Children of pods are the steps or tasks that follow them, not what they contain
+		for descendant := range nodeDescendants(wf.Status.Nodes, node.Children...) {
+			if node.Type != wfv1.NodeTypePod && removed[descendant] {
+				newNode.Phase = wfv1.NodeRunning
+				newNode.Message = ""
+				newNode.FinishedAt = metav1.Time{}
+				newNode.Outputs = nil
+				break
+			}
+		}
+
+		newWf.Status.Nodes[id] = *newNode
+	}
+
+	return newWf, podNames, nil
+}
+
+// restartWorkflow deletes the pods of the nodes that run again and updates the argo workflow.
+// See formulateRestartWorkflow.
+func (c *Client) restartWorkflow(wf *wfv1.Workflow, nodeID string) error {
+	newWf, podNames, err := formulateRestartWorkflow(wf, nodeID)
+	if err != nil {
+		return err
+	}
+
+	for _, podName := range podNames {
+		err := c.CoreV1().Pods(wf.Namespace).Delete(podName, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return workflowTransitionError(wf.Namespace, wf.Name, err)
+		}
+	}
+
+	if _, err := c.ArgoprojV1alpha1().Workflows(wf.Namespace).Update(newWf); err != nil {
+		return workflowTransitionError(wf.Namespace, wf.Name, err)
+	}
+
+	return nil
+}
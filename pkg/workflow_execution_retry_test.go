@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"sort"
+	"testing"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestRestartWorkflow(phase wfv1.NodePhase) *wfv1.Workflow {
+	deadline := int64(0)
+
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "wf",
+			Namespace: "onepanel",
+			Labels:    map[string]string{},
+		},
+		Spec: wfv1.WorkflowSpec{
+			ActiveDeadlineSeconds: &deadline,
+			Shutdown:              wfv1.ShutdownStrategyTerminate,
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase: phase,
+			Nodes: map[string]wfv1.NodeStatus{
+				"wf":        {ID: "wf", Name: "wf", Type: wfv1.NodeTypeSteps, Phase: phase, Children: []string{"sg0"}},
+				"sg0":       {ID: "sg0", Name: "wf[0]", Type: wfv1.NodeTypeStepGroup, Phase: wfv1.NodeSucceeded, Children: []string{"prepare"}},
+				"prepare":   {ID: "prepare", Name: "wf[0].prepare", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, Children: []string{"sg1"}},
+				"sg1":       {ID: "sg1", Name: "wf[1]", Type: wfv1.NodeTypeStepGroup, Phase: phase, Children: []string{"train"}},
+				"train":     {ID: "train", Name: "wf[1].train", Type: wfv1.NodeTypePod, Phase: phase},
+				"wf-onexit": {ID: "wf-onexit", Name: "wf.onExit", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded},
+			},
+		},
+	}
+}
+
+func TestFormulateRestartWorkflow(t *testing.T) {
+	t.Run("Restart From Node", func(t *testing.T) {
+		wf, podNames, err := formulateRestartWorkflow(newTestRestartWorkflow(wfv1.NodeSucceeded), "train")
+		assert.Nil(t, err)
+
+		sort.Strings(podNames)
+		assert.Equal(t, []string{"train", "wf-onexit"}, podNames)
+
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Phase)
+		assert.Nil(t, wf.Spec.ActiveDeadlineSeconds)
+		assert.Empty(t, wf.Spec.Shutdown)
+
+		assert.Len(t, wf.Status.Nodes, 4)
+		assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Nodes["prepare"].Phase)
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["sg1"].Phase)
+		assert.Empty(t, wf.Status.Nodes["sg1"].Children)
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["wf"].Phase)
+	})
+
+	t.Run("Restarts Failed Nodes", func(t *testing.T) {
+		wf, podNames, err := formulateRestartWorkflow(newTestRestartWorkflow(wfv1.NodeFailed), "prepare")
+		assert.Nil(t, err)
+
+		sort.Strings(podNames)
+		assert.Equal(t, []string{"prepare", "train", "wf-onexit"}, podNames)
+		assert.Len(t, wf.Status.Nodes, 2)
+		assert.Equal(t, wfv1.NodeRunning, wf.Status.Nodes["sg0"].Phase)
+	})
+
+	t.Run("Running", func(t *testing.T) {
+		_, _, err := formulateRestartWorkflow(newTestRestartWorkflow(wfv1.NodeRunning), "train")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Unknown Node", func(t *testing.T) {
+		_, _, err := formulateRestartWorkflow(newTestRestartWorkflow(wfv1.NodeFailed), "missing")
+		assert.NotNil(t, err)
+	})
+}
@@ -9,6 +9,15 @@ import (
 	"time"
 )
 
+// Phases of workflow executions in the database that argo does not have
+const (
+	// WorkflowExecutionSuspended is the phase of a suspended workflow execution. Argo reports it as Running.
+	WorkflowExecutionSuspended wfv1.NodePhase = "Suspended"
+	// WorkflowExecutionQueued is the phase of a workflow execution that waits in the execution queue.
+	// It is not submitted to argo until it is dispatched.
+	WorkflowExecutionQueued wfv1.NodePhase = "Queued"
+)
+
 // WorkflowExecution represents a workflow that is either in execution, or finished/failed.
 type WorkflowExecution struct {
 	ID               uint64
@@ -26,6 +35,14 @@ type WorkflowExecution struct {
 	WorkflowTemplate *WorkflowTemplate `db:"workflow_template"`
 	Labels           types.JSONLabels
 	ArgoWorkflow     *wfv1.Workflow
+	// Priority orders queued workflow executions, higher priorities are dispatched first
+	Priority int32
+	QueuedAt *time.Time `db:"queued_at"`
+	// ResourceVersion is the version of the argo workflow the workflow execution was loaded from, if any.
+	// Watches resume after it.
+	ResourceVersion string `db:"-"`
+	// QueuePosition is the 1-based position of a queued workflow execution in its namespace's queue
+	QueuePosition int `db:"-"`
 }
 
 // WorkflowExecutionOptions are options you have for an executing workflow
@@ -38,6 +55,9 @@ type WorkflowExecutionOptions struct {
 	Labels         map[string]string
 	ListOptions    *ListOptions
 	PodGCStrategy  *PodGCStrategy
+	// Queue is true if the execution is subject to the execution queue limits
+	Queue    bool
+	Priority int32
 }
 
 // WorkflowExecutionStatistic is a record keeping track of what happened to a workflow execution
@@ -105,7 +125,7 @@ func (we *WorkflowExecution) GetParameterValue(name string) *string {
 // getWorkflowExecutionColumns returns all of the columns for workflowExecution modified by alias, destination.
 // see formatColumnSelect
 func getWorkflowExecutionColumns(aliasAndDestination ...string) []string {
-	columns := []string{"id", "created_at", "uid", "name", "parameters", "phase", "started_at", "finished_at", "labels"}
+	columns := []string{"id", "created_at", "uid", "name", "parameters", "phase", "started_at", "finished_at", "labels", "priority", "queued_at"}
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
 
@@ -579,22 +579,17 @@ func (c *Client) UpdateWorkspaceStatus(namespace, uid string, status *WorkspaceS
 		}
 	}
 
-	result, err := updateWorkspaceStatusBuilder(namespace, uid, status).
-		RunWith(c.DB).
-		Exec()
+	before, updated, err := c.updateNotificationSource("workspaces", sq.Eq{"namespace": namespace, "uid": uid}, updateWorkspaceStatusBuilder(namespace, uid, status))
 	if err != nil {
 		return err
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return err
-	}
-
-	if rowsAffected == 0 {
+	if !updated {
 		return util.NewUserError(codes.NotFound, "Workspace not found.")
 	}
 
+	c.notifyWorkspacePhase(namespace, before, status.Phase)
+
 	return
 }
 
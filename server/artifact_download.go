@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/grpclog"
+)
+
+// artifactChunkSize is the size of the chunks artifacts are streamed in
+const artifactChunkSize = 256 * 1024
+
+// pattern_ArtifactDownload_0 is /apis/v1beta1/{namespace}/workflow_executions/{uid}/download/{key=**}
+var pattern_ArtifactDownload_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 3, 0, 4, 1, 5, 6}, []string{"apis", "v1beta1", "namespace", "workflow_executions", "uid", "download", "key"}, "", runtime.AssumeColonVerbOpt(true)))
+
+// parseRangeHeader returns the offset and length of a "bytes=" Range header, as GetArtifactStream expects them.
+// ok is false if the header is missing or is not a single byte range, then the whole artifact is sent.
+func parseRangeHeader(header string) (offset, length int64, ok bool) {
+	if !strings.HasPrefix(header, "bytes=") || strings.Contains(header, ",") {
+		return 0, 0, false
+	}
+
+	parts := strings.SplitN(strings.TrimSpace(strings.TrimPrefix(header, "bytes=")), "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+
+	// bytes=-N is the last N bytes
+	if parts[0] == "" {
+		suffix, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil || suffix <= 0 {
+			return 0, 0, false
+		}
+		return -suffix, 0, true
+	}
+
+	offset, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || offset < 0 {
+		return 0, 0, false
+	}
+	if parts[1] == "" {
+		return offset, 0, true
+	}
+
+	end, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || end < offset {
+		return 0, 0, false
+	}
+
+	return offset, end - offset + 1, true
+}
+
+// RegisterArtifactDownloadHandlerFromEndpoint registers the artifact download handler of the WorkflowService to "mux".
+// It writes the chunks of GetArtifactStream as the body of the response, so artifacts can be downloaded with plain HTTP
+// clients, and it answers Range requests with partial content.
+func RegisterArtifactDownloadHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		if cerr := conn.Close(); cerr != nil {
+			grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+		}
+	}()
+
+	client := api.NewWorkflowServiceClient(conn)
+	mux.Handle("GET", pattern_ArtifactDownload_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		streamReq := &api.GetArtifactStreamRequest{
+			Namespace: pathParams["namespace"],
+			Uid:       pathParams["uid"],
+			Key:       pathParams["key"],
+		}
+		offset, length, partial := parseRangeHeader(req.Header.Get("Range"))
+		if partial {
+			streamReq.Offset = offset
+			streamReq.Length = length
+		}
+
+		stream, err := client.GetArtifactStream(rctx, streamReq)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		// The first chunk has the content type, size and range, errors of the request are returned instead of it
+		header, err := stream.Recv()
+		if err != nil {
+			if info := util.ErrorInfo(err, v1.ArtifactOutOfRangeReason); partial && info != nil {
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes */%v", info.Metadata["size"]))
+				w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		w.Header().Set("Accept-Ranges", "bytes")
+		w.Header().Set("Content-Type", header.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(header.Length, 10))
+		// An empty artifact has no bytes to send a range of, the whole, empty, artifact is sent instead
+		if partial && header.Length > 0 {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %v-%v/%v", header.Offset, header.Offset+header.Length-1, header.Size))
+			w.WriteHeader(http.StatusPartialContent)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				// The status is already sent, the client sees a body shorter than Content-Length
+				grpclog.Infof("Failed to stream artifact: %v", err)
+				return
+			}
+			if _, err := w.Write(chunk.Data); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
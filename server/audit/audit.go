@@ -0,0 +1,269 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/server/auth"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// redactedValue replaces sensitive values in recorded requests
+	redactedValue = "[REDACTED]"
+	// maxValueLength is the longest string value kept in a recorded request, longer values such as manifests are truncated
+	maxValueLength = 1024
+)
+
+// readPrefixes are the method name prefixes of RPCs that don't change anything, these are not audited
+var readPrefixes = []string{"Get", "List", "Watch", "Is", "Generate"}
+
+// telemetryMethods record data that running workflows produce, such as metrics.
+// They are called too often to audit and don't change any resource.
+var telemetryMethods = map[string]bool{
+	"LogMetrics": true,
+}
+
+// sensitiveKeys are request fields whose string values are always redacted
+var sensitiveKeys = map[string]bool{
+	"password": true,
+	"token":    true,
+	"secret":   true,
+	// chunk is the content of an uploaded file
+	"chunk": true,
+}
+
+type namespaceGetter interface {
+	GetNamespace() string
+}
+
+type uidGetter interface {
+	GetUid() string
+}
+
+type nameGetter interface {
+	GetName() string
+}
+
+type secretNameGetter interface {
+	GetSecretName() string
+}
+
+// IsReadMethod returns true if the full grpc method name, e.g. /api.WorkspaceService/ListWorkspaces, only reads data.
+// Telemetry methods are treated as reads, they don't change any resource.
+func IsReadMethod(fullMethod string) bool {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if strings.HasSuffix(method, "Exists") || telemetryMethods[method] {
+		return true
+	}
+
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getNamespace returns the namespace the request is for, if it has one
+func getNamespace(req interface{}) string {
+	if getter, ok := req.(namespaceGetter); ok {
+		return getter.GetNamespace()
+	}
+
+	return ""
+}
+
+// getResourceUID returns the uid, or name, of the resource the request is for.
+// Requests that create a resource don't have a uid, so the response is checked as well.
+func getResourceUID(req, resp interface{}) string {
+	for _, message := range []interface{}{req, resp} {
+		if getter, ok := message.(uidGetter); ok && getter.GetUid() != "" {
+			return getter.GetUid()
+		}
+	}
+
+	if getter, ok := req.(nameGetter); ok && getter.GetName() != "" {
+		return getter.GetName()
+	}
+
+	if getter, ok := req.(secretNameGetter); ok {
+		return getter.GetSecretName()
+	}
+
+	return ""
+}
+
+// redact replaces sensitive values in the decoded json value and truncates long strings
+func redact(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		// Parameters of a password type hold their secret in the value
+		if parameterType, ok := typed["type"].(string); ok && strings.Contains(strings.ToLower(parameterType), "password") {
+			if _, ok := typed["value"]; ok {
+				typed["value"] = redactedValue
+			}
+		}
+
+		for key, item := range typed {
+			lowerKey := strings.ToLower(key)
+			if lowerKey == "data" {
+				// Secret data, keep the keys so it's clear what changed
+				if data, ok := item.(map[string]interface{}); ok {
+					for dataKey := range data {
+						data[dataKey] = redactedValue
+					}
+					continue
+				}
+			}
+
+			if _, ok := item.(string); ok && sensitiveKeys[lowerKey] {
+				typed[key] = redactedValue
+				continue
+			}
+
+			typed[key] = redact(item)
+		}
+		return typed
+	case []interface{}:
+		for i := range typed {
+			typed[i] = redact(typed[i])
+		}
+		return typed
+	case string:
+		if len(typed) > maxValueLength {
+			return typed[:maxValueLength] + "...(truncated)"
+		}
+	}
+
+	return value
+}
+
+// summarizeRequest returns the request as JSON with sensitive values redacted
+func summarizeRequest(req interface{}) string {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return "{}"
+	}
+
+	marshaler := jsonpb.Marshaler{}
+	encoded, err := marshaler.MarshalToString(message)
+	if err != nil {
+		return "{}"
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(encoded), &decoded); err != nil {
+		return "{}"
+	}
+
+	result, err := json.Marshal(redact(decoded))
+	if err != nil {
+		return "{}"
+	}
+
+	return string(result)
+}
+
+// getUsername returns the user that made the request, or an empty string if it can't be determined
+func getUsername(ctx context.Context) string {
+	client, ok := ctx.Value(auth.ContextClientKey).(*v1.Client)
+	if !ok {
+		return ""
+	}
+
+	identity, err := auth.ResolveIdentity(ctx, client)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Error": err.Error(),
+		}).Warn("Unable to resolve identity for audit event.")
+		return ""
+	}
+
+	return identity.Username
+}
+
+// UnaryInterceptor records an audit event for every request that may change something.
+// It must be chained after auth.UnaryInterceptor so the identity of the caller is known,
+// requests that fail authentication never reach it.
+// Failing to record an event is logged, but does not fail the request since the change has already been made.
+func UnaryInterceptor(db *v1.DB) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		if ctx == nil || IsReadMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err = handler(ctx, req)
+
+		event := &v1.AuditEvent{
+			Username:    getUsername(ctx),
+			Method:      info.FullMethod,
+			Namespace:   getNamespace(req),
+			ResourceUID: getResourceUID(req, resp),
+			Request:     summarizeRequest(req),
+			Code:        status.Code(err).String(),
+			LatencyMS:   time.Since(start).Milliseconds(),
+		}
+
+		client := &v1.Client{DB: db}
+		_ = client.CreateAuditEvent(event)
+
+		return resp, err
+	}
+}
+
+// auditedStream keeps the first message the client sends, it describes the request the stream is for
+type auditedStream struct {
+	grpc.ServerStream
+	req interface{}
+}
+
+// RecvMsg receives the next message of the stream and keeps it if it is the first one
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if message, ok := m.(proto.Message); ok && err == nil && s.req == nil {
+		s.req = proto.Clone(message)
+	}
+
+	return err
+}
+
+// StreamInterceptor records an audit event for every streaming request that may change something, such as uploads.
+// The namespace and request are taken from the first message the client sends.
+// It must be chained after auth.StreamingInterceptor so the identity of the caller is known.
+func StreamInterceptor(db *v1.DB) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if IsReadMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		stream := &auditedStream{ServerStream: ss}
+
+		start := time.Now()
+		err := handler(srv, stream)
+
+		event := &v1.AuditEvent{
+			Username:    getUsername(ss.Context()),
+			Method:      info.FullMethod,
+			Namespace:   getNamespace(stream.req),
+			ResourceUID: getResourceUID(stream.req, nil),
+			Request:     summarizeRequest(stream.req),
+			Code:        status.Code(err).String(),
+			LatencyMS:   time.Since(start).Milliseconds(),
+		}
+
+		client := &v1.Client{DB: db}
+		_ = client.CreateAuditEvent(event)
+
+		return err
+	}
+}
@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/onepanelio/core/api"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// TestIsReadMethod makes sure only methods that may change something are audited
+func TestIsReadMethod(t *testing.T) {
+	assert.True(t, IsReadMethod("/api.WorkspaceService/ListWorkspaces"))
+	assert.True(t, IsReadMethod("/api.WorkflowService/GetWorkflowExecution"))
+	assert.True(t, IsReadMethod("/api.SecretService/SecretExists"))
+	assert.True(t, IsReadMethod("/api.AuthService/IsValidToken"))
+	assert.True(t, IsReadMethod("/api.WorkflowService/LogMetrics"))
+
+	assert.False(t, IsReadMethod("/api.WorkspaceService/DeleteWorkspace"))
+	assert.False(t, IsReadMethod("/api.WorkflowTemplateService/ArchiveWorkflowTemplate"))
+	assert.False(t, IsReadMethod("/api.SecretService/UpdateSecretKeyValue"))
+}
+
+// TestSummarizeRequest_Secret makes sure secret values are redacted but their keys are kept
+func TestSummarizeRequest_Secret(t *testing.T) {
+	summary := summarizeRequest(&api.CreateSecretRequest{
+		Namespace: "onepanel",
+		Secret: &api.Secret{
+			Name: "aws",
+			Data: map[string]string{
+				"accessKey": "AKIA",
+				"secretKey": "hunter2",
+			},
+		},
+	})
+
+	assert.NotContains(t, summary, "AKIA")
+	assert.NotContains(t, summary, "hunter2")
+	assert.Contains(t, summary, "secretKey")
+	assert.Contains(t, summary, `"name":"aws"`)
+}
+
+// TestSummarizeRequest_Parameters makes sure password parameters are redacted and long values truncated
+func TestSummarizeRequest_Parameters(t *testing.T) {
+	summary := summarizeRequest(&api.CreateWorkspaceRequest{
+		Namespace: "onepanel",
+		Body: &api.CreateWorkspaceBody{
+			Parameters: []*api.Parameter{
+				{Name: "password", Value: "hunter2", Type: "input.password"},
+				{Name: "notes", Value: strings.Repeat("a", maxValueLength*2), Type: "textarea.textarea"},
+				{Name: "machine-type", Value: "cpu", Type: "select.select"},
+			},
+		},
+	})
+
+	assert.NotContains(t, summary, "hunter2")
+	assert.Contains(t, summary, `"value":"cpu"`)
+	assert.Contains(t, summary, "(truncated)")
+
+	var decoded map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(summary), &decoded))
+}
+
+// TestGetResourceUID makes sure the uid comes from the request or, for created resources, from the response
+func TestGetResourceUID(t *testing.T) {
+	assert.Equal(t, "ws", getResourceUID(&api.DeleteWorkspaceRequest{Uid: "ws"}, nil))
+	assert.Equal(t, "aws", getResourceUID(&api.DeleteSecretRequest{Name: "aws"}, nil))
+	assert.Equal(t, "aws", getResourceUID(&api.DeleteSecretKeyRequest{SecretName: "aws"}, nil))
+	assert.Equal(t, "wf", getResourceUID(&api.CreateWorkflowExecutionRequest{}, &api.WorkflowExecution{Uid: "wf"}))
+	assert.Equal(t, "", getResourceUID(&api.CreateWorkflowExecutionRequest{}, (*api.WorkflowExecution)(nil)))
+}
+
+// recvStream is a grpc.ServerStream that receives the given messages
+type recvStream struct {
+	grpc.ServerStream
+	messages []*api.UploadArtifactRequest
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	if len(s.messages) == 0 {
+		return io.EOF
+	}
+
+	proto.Merge(m.(proto.Message), s.messages[0])
+	s.messages = s.messages[1:]
+
+	return nil
+}
+
+// TestAuditedStream makes sure the first message of an upload is kept for the audit event without the file content
+func TestAuditedStream(t *testing.T) {
+	stream := &auditedStream{ServerStream: &recvStream{messages: []*api.UploadArtifactRequest{
+		{Namespace: "onepanel", Key: "a.txt", Chunk: []byte("hunter2")},
+		{Namespace: "other", Key: "b.txt"},
+	}}}
+
+	for {
+		req := &api.UploadArtifactRequest{}
+		if err := stream.RecvMsg(req); err != nil {
+			break
+		}
+	}
+
+	assert.Equal(t, "onepanel", getNamespace(stream.req))
+
+	summary := summarizeRequest(stream.req)
+	assert.Contains(t, summary, "a.txt")
+	assert.NotContains(t, summary, "b.txt")
+	assert.NotContains(t, summary, "aHVudGVyMg")
+}
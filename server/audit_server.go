@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+)
+
+// AuditServer contains actions for the audit log
+type AuditServer struct{}
+
+// NewAuditServer creates a new AuditServer
+func NewAuditServer() *AuditServer {
+	return &AuditServer{}
+}
+
+// parseOptionalTime parses an RFC3339 timestamp, an empty value is returned as nil
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	result, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, field+" must be in RFC3339 format.")
+	}
+
+	return &result, nil
+}
+
+// ListAuditEvents returns the audit events matching the filters, newest first.
+// Audit events aren't kubernetes resources, access is granted with RBAC rules for the "auditevents" resource in the "onepanel.io" group.
+func (s *AuditServer) ListAuditEvents(ctx context.Context, req *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "onepanel.io", "auditevents", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	since, err := parseOptionalTime("since", req.Since)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTime("until", req.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &v1.AuditEventFilter{
+		Namespace:   req.Namespace,
+		Username:    req.Username,
+		ResourceUID: req.ResourceUid,
+		Since:       since,
+		Until:       until,
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	events, err := client.ListAuditEvents(filter, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	apiEvents := make([]*api.AuditEvent, 0)
+	for _, event := range events {
+		apiEvents = append(apiEvents, converter.AuditEventToAPI(event))
+	}
+
+	count, err := client.CountAuditEvents(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListAuditEventsResponse{
+		Count:       int32(len(apiEvents)),
+		AuditEvents: apiEvents,
+		Page:        int32(paginator.Page),
+		Pages:       paginator.CalculatePages(count),
+		TotalCount:  int32(count),
+	}, nil
+}
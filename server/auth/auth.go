@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"github.com/onepanelio/core/api"
 	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 	"net/http"
 	"strings"
+	"sync"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	v1 "github.com/onepanelio/core/pkg"
@@ -18,6 +21,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
 )
 
@@ -65,19 +69,120 @@ func getBearerToken(ctx context.Context) (*string, bool) {
 	return nil, false
 }
 
-func getClient(ctx context.Context, kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfig) (context.Context, error) {
+// BearerToken returns the token the request is authenticated with
+func BearerToken(ctx context.Context) (string, bool) {
+	token, ok := getBearerToken(ctx)
+	if !ok {
+		return "", false
+	}
+
+	return *token, true
+}
+
+var (
+	serverConfig     *v1.Config
+	serverConfigOnce sync.Once
+)
+
+// getServerConfig returns the kubernetes config of the server's own service account.
+// It is only loaded when it is first needed.
+func getServerConfig() *v1.Config {
+	serverConfigOnce.Do(func() {
+		serverConfig = v1.NewConfig()
+	})
+
+	return serverConfig
+}
+
+// oidcAuthenticator creates clients for users that authenticate with a token from the configured OIDC provider.
+// Kubernetes doesn't know about these tokens, so the server's own service account impersonates the token's user.
+type oidcAuthenticator struct {
+	verifier *OIDCVerifier
+}
+
+// newOIDCAuthenticator returns an authenticator if oidc is configured in the system config, nil otherwise.
+func newOIDCAuthenticator(sysConfig v1.SystemConfig) *oidcAuthenticator {
+	verifier := newOIDCVerifierFromSystemConfig(sysConfig)
+	if verifier == nil {
+		return nil
+	}
+
+	return &oidcAuthenticator{
+		verifier: verifier,
+	}
+}
+
+// getClient verifies the token and returns a client that impersonates the identity it was issued to.
+func (a *oidcAuthenticator) getClient(token string, clients *clientFactory, db *v1.DB, sysConfig v1.SystemConfig) (*v1.Client, error) {
+	identity, err := a.verifier.Verify(token)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Error": err.Error(),
+		}).Info("Invalid oidc token.")
+		return nil, status.Error(codes.Unauthenticated, "Invalid token.")
+	}
+
+	return clients.newImpersonatingClient(identity, db, sysConfig)
+}
+
+// getAPITokenClient looks up the API token and returns a client that impersonates the identity it was created for.
+// The token's scope is enforced by IsAuthorized.
+func getAPITokenClient(secret string, clients *clientFactory, db *v1.DB, sysConfig v1.SystemConfig) (*v1.Client, error) {
+	lookupClient := &v1.Client{DB: db}
+	token, err := lookupClient.GetAPITokenBySecret(secret)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Error": err.Error(),
+		}).Error("Unable to look up API token.")
+		return nil, status.Error(codes.Unavailable, "Unable to verify token.")
+	}
+	if token == nil || !token.IsActive() {
+		return nil, status.Error(codes.Unauthenticated, "Invalid, expired or revoked token.")
+	}
+
+	if err := lookupClient.TouchAPIToken(token); err != nil {
+		log.WithFields(log.Fields{
+			"UID":   token.UID,
+			"Error": err.Error(),
+		}).Warn("Unable to update API token last used time.")
+	}
+
+	client, err := clients.newImpersonatingClient(token.Identity(), db, sysConfig)
+	if err != nil {
+		return nil, err
+	}
+	client.Token = token
+
+	return client, nil
+}
+
+// getClient returns a context with a client for the credentials of the request.
+// sysConfig is shared by all requests and is not modified.
+func getClient(ctx context.Context, clients *clientFactory, db *v1.DB, sysConfig v1.SystemConfig, oidc *oidcAuthenticator) (context.Context, error) {
 	bearerToken, ok := getBearerToken(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, `Missing or invalid "authorization" header.`)
 	}
 
-	if sysConfig["token"] != *bearerToken {
-		sysConfig["token"] = *bearerToken
+	if v1.IsAPIToken(*bearerToken) {
+		client, err := getAPITokenClient(*bearerToken, clients, db, sysConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return context.WithValue(ctx, ContextClientKey, client), nil
 	}
 
-	kubeConfig.BearerToken = *bearerToken
+	if oidc != nil && oidc.verifier.IsIssuedBy(*bearerToken) {
+		client, err := oidc.getClient(*bearerToken, clients, db, sysConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return context.WithValue(ctx, ContextClientKey, client), nil
+	}
 
-	client, err := v1.NewClient(kubeConfig, db, sysConfig)
+	client, err := clients.newBearerTokenClient(*bearerToken, db, sysConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -85,24 +190,89 @@ func getClient(ctx context.Context, kubeConfig *v1.Config, db *v1.DB, sysConfig
 	return context.WithValue(ctx, ContextClientKey, client), nil
 }
 
-func IsAuthorized(c *v1.Client, namespace, verb, group, resource, name string) (allowed bool, err error) {
-	review, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
-		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
-			ResourceAttributes: &authorizationv1.ResourceAttributes{
-				Namespace: namespace,
-				Verb:      verb,
-				Group:     group,
-				Resource:  resource,
-				Name:      name,
-			},
+// ResolveIdentity returns the kubernetes user the client acts as.
+// Clients created from kubernetes tokens don't know their user, so the token is reviewed with the server's credentials.
+func ResolveIdentity(ctx context.Context, client *v1.Client) (*v1.Identity, error) {
+	if client.Identity != nil {
+		return client.Identity, nil
+	}
+
+	bearerToken, ok := getBearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, `Missing or invalid "authorization" header.`)
+	}
+
+	serverClient, err := kubernetes.NewForConfig(getServerConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := serverClient.AuthenticationV1().TokenReviews().Create(&authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: *bearerToken,
 		},
 	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Error": err.Error(),
+		}).Error("Unable to review token.")
+		return nil, status.Error(codes.Unavailable, "Unable to verify token.")
+	}
+	if !review.Status.Authenticated {
+		return nil, status.Error(codes.Unauthenticated, "Invalid token.")
+	}
 
+	client.Identity = &v1.Identity{
+		Username: review.Status.User.Username,
+		Groups:   review.Status.User.Groups,
+	}
+
+	return client.Identity, nil
+}
+
+// IsAuthorized checks if the client may perform the verb on the resource with a SelfSubjectAccessReview.
+// Decisions are cached for a short time per client credentials, see authorizationCache.
+func IsAuthorized(c *v1.Client, namespace, verb, group, resource, name string) (allowed bool, err error) {
 	deniedMsg := fmt.Sprintf(`Permission denied. Namespace: '%v', Verb: '%v', Group: '%v', Resource '%v', Name: '%v'`, namespace, verb, group, resource, name)
-	if err != nil {
-		return false, status.Error(codes.PermissionDenied, deniedMsg)
+	if c.Token != nil && !c.Token.Allows(namespace, verb) {
+		return false, status.Error(codes.PermissionDenied, deniedMsg+" The token's scope does not allow this.")
+	}
+
+	key := decisionKey{
+		credential: c.CredentialKey,
+		namespace:  namespace,
+		verb:       verb,
+		group:      group,
+		resource:   resource,
+		name:       name,
+	}
+	cached := false
+	if key.credential != "" {
+		allowed, cached = decisionCache.get(key)
 	}
-	allowed = review.Status.Allowed
+
+	if !cached {
+		review, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      verb,
+					Group:     group,
+					Resource:  resource,
+					Name:      name,
+				},
+			},
+		})
+		if err != nil {
+			return false, status.Error(codes.PermissionDenied, deniedMsg)
+		}
+
+		allowed = review.Status.Allowed
+		if key.credential != "" {
+			decisionCache.set(key, allowed)
+		}
+	}
+
 	if !allowed {
 		return false, status.Error(codes.PermissionDenied, deniedMsg)
 	}
@@ -150,8 +320,14 @@ func verifyLogin(client *v1.Client, tokenRequest *api.IsValidTokenRequest) (rawT
 // UnaryInterceptor performs authentication checks.
 // The two main cases are:
 //   1. Is the token valid? This is used for logging in.
-//   2. Is there a token? There should be a token for everything except logging in.
+//   2. Is there a token? There should be a token for everything except logging in and invoking triggers.
+//
+// Tokens issued by the OIDC provider in the "oidc" system config are also accepted, see oidcAuthenticator,
+// as are API tokens created with the TokenService, see getAPITokenClient.
 func UnaryInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfig) grpc.UnaryServerInterceptor {
+	oidc := newOIDCAuthenticator(sysConfig)
+	clients := newClientFactory(kubeConfig)
+
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		// Check if the provided token is valid. This does not require a token in the header.
 		if info.FullMethod == "/api.AuthService/IsValidToken" {
@@ -182,7 +358,7 @@ func UnaryInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfi
 
 			md.Set("onepanel-auth-token", rawToken)
 
-			ctx, err = getClient(ctx, kubeConfig, db, sysConfig)
+			ctx, err = getClient(ctx, clients, db, sysConfig, oidc)
 			if err != nil {
 				ctx = nil
 			}
@@ -220,8 +396,18 @@ func UnaryInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfi
 			}
 		}
 
+		// Triggers are invoked by external systems without kubernetes credentials, the trigger checks the token in its url
+		if info.FullMethod == "/api.TriggerService/InvokeTrigger" {
+			client, err := clients.newServerClient(db, sysConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			return handler(context.WithValue(ctx, ContextClientKey, client), req)
+		}
+
 		// This guy checks for the token
-		ctx, err = getClient(ctx, kubeConfig, db, sysConfig)
+		ctx, err = getClient(ctx, clients, db, sysConfig, oidc)
 		if err != nil {
 			return
 		}
@@ -232,8 +418,11 @@ func UnaryInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfi
 
 // StreamingInterceptor provides an authentication wrapper around streaming requests.
 func StreamingInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfig) grpc.StreamServerInterceptor {
+	oidc := newOIDCAuthenticator(sysConfig)
+	clients := newClientFactory(kubeConfig)
+
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
-		ctx, err := getClient(ss.Context(), kubeConfig, db, sysConfig)
+		ctx, err := getClient(ss.Context(), clients, db, sysConfig, oidc)
 		if err != nil {
 			return
 		}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"expvar"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/onepanelio/core/pkg"
+)
+
+const (
+	// allowedDecisionTTL is how long an allowed decision is reused before it is checked with kubernetes again
+	allowedDecisionTTL = 30 * time.Second
+	// deniedDecisionTTL is shorter than allowedDecisionTTL so newly granted permissions are picked up quickly
+	deniedDecisionTTL = 5 * time.Second
+	// maxCachedDecisions bounds the memory used by the cache
+	maxCachedDecisions = 10000
+)
+
+// authorizationCacheStats are published with expvar as "authorization_cache"
+var authorizationCacheStats = expvar.NewMap("authorization_cache")
+
+// decisionKey identifies an authorization decision for a set of credentials
+type decisionKey struct {
+	credential string
+	namespace  string
+	verb       string
+	group      string
+	resource   string
+	name       string
+}
+
+type decision struct {
+	allowed   bool
+	expiresAt time.Time
+}
+
+// authorizationCache stores the results of SelfSubjectAccessReviews so repeated checks don't go to the kubernetes api
+type authorizationCache struct {
+	mu        sync.Mutex
+	decisions map[decisionKey]decision
+	now       func() time.Time
+}
+
+func newAuthorizationCache() *authorizationCache {
+	return &authorizationCache{
+		decisions: make(map[decisionKey]decision),
+		now:       time.Now,
+	}
+}
+
+var decisionCache = newAuthorizationCache()
+
+func init() {
+	authorizationCacheStats.Set("entries", expvar.Func(func() interface{} {
+		return decisionCache.len()
+	}))
+}
+
+// get returns the cached decision for key, ok is false if there is none or it has expired
+func (c *authorizationCache) get(key decisionKey) (allowed bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.decisions[key]
+	if !ok || !c.now().Before(cached.expiresAt) {
+		authorizationCacheStats.Add("misses", 1)
+		return false, false
+	}
+
+	authorizationCacheStats.Add("hits", 1)
+
+	return cached.allowed, true
+}
+
+// set stores the decision for key
+func (c *authorizationCache) set(key decisionKey, allowed bool) {
+	ttl := deniedDecisionTTL
+	if allowed {
+		ttl = allowedDecisionTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.decisions) >= maxCachedDecisions {
+		c.evictExpired()
+	}
+	// Everything is still valid, start over rather than tracking usage for each entry
+	if len(c.decisions) >= maxCachedDecisions {
+		authorizationCacheStats.Add("evictions", int64(len(c.decisions)))
+		c.decisions = make(map[decisionKey]decision)
+	}
+
+	c.decisions[key] = decision{
+		allowed:   allowed,
+		expiresAt: c.now().Add(ttl),
+	}
+}
+
+// evictExpired removes the expired decisions. The lock must be held.
+func (c *authorizationCache) evictExpired() {
+	now := c.now()
+	for key, cached := range c.decisions {
+		if !now.Before(cached.expiresAt) {
+			delete(c.decisions, key)
+			authorizationCacheStats.Add("evictions", 1)
+		}
+	}
+}
+
+// invalidate removes the decisions for credential, or all decisions if credential is empty
+func (c *authorizationCache) invalidate(credential string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	authorizationCacheStats.Add("invalidations", 1)
+
+	if credential == "" {
+		c.decisions = make(map[decisionKey]decision)
+		return
+	}
+
+	for key := range c.decisions {
+		if key.credential == credential {
+			delete(c.decisions, key)
+		}
+	}
+}
+
+func (c *authorizationCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.decisions)
+}
+
+// InvalidateAuthorizationCache removes all cached authorization decisions.
+// Call it when permissions may have changed, e.g. after RBAC or configuration updates.
+func InvalidateAuthorizationCache() {
+	decisionCache.invalidate("")
+}
+
+// InvalidateAuthorizationCacheFor removes the cached authorization decisions of the client's credentials
+func InvalidateAuthorizationCacheFor(client *v1.Client) {
+	if client.CredentialKey == "" {
+		return
+	}
+
+	decisionCache.invalidate(client.CredentialKey)
+}
+
+// bearerTokenCredentialKey returns the credential key for a client that authenticates with the token
+func bearerTokenCredentialKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return "token:" + hex.EncodeToString(sum[:])
+}
+
+// serverCredentialKey is the credential key of clients that use the server's own credentials
+const serverCredentialKey = "server"
+
+// identityCredentialKey returns the credential key for a client that impersonates the identity
+func identityCredentialKey(identity *v1.Identity) string {
+	groups := append([]string{}, identity.Groups...)
+	sort.Strings(groups)
+
+	sum := sha256.Sum256([]byte(identity.Username + "\n" + strings.Join(groups, "\n")))
+
+	return "identity:" + hex.EncodeToString(sum[:])
+}
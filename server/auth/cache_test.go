@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newReviewClient returns a client whose SelfSubjectAccessReviews return allowed, and a counter of the reviews made
+func newReviewClient(credential string, allowed bool) (*v1.Client, *int) {
+	reviews := 0
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{
+				Allowed: allowed,
+			},
+		}, nil
+	})
+
+	return &v1.Client{
+		Interface:     kubeClient,
+		CredentialKey: credential,
+	}, &reviews
+}
+
+// TestIsAuthorized_Cache makes sure repeated checks are answered from the cache
+func TestIsAuthorized_Cache(t *testing.T) {
+	InvalidateAuthorizationCache()
+
+	client, reviews := newReviewClient(bearerTokenCredentialKey("cached"), true)
+	for i := 0; i < 3; i++ {
+		allowed, err := IsAuthorized(client, "onepanel", "get", "onepanel.io", "workspaces", "jupyter")
+		assert.Nil(t, err)
+		assert.True(t, allowed)
+	}
+	assert.Equal(t, 1, *reviews)
+
+	// A different resource is a different decision
+	_, _ = IsAuthorized(client, "onepanel", "delete", "onepanel.io", "workspaces", "jupyter")
+	assert.Equal(t, 2, *reviews)
+
+	InvalidateAuthorizationCacheFor(client)
+	_, _ = IsAuthorized(client, "onepanel", "get", "onepanel.io", "workspaces", "jupyter")
+	assert.Equal(t, 3, *reviews)
+}
+
+// TestIsAuthorized_CacheDenied makes sure denied decisions are cached, and still denied
+func TestIsAuthorized_CacheDenied(t *testing.T) {
+	InvalidateAuthorizationCache()
+
+	client, reviews := newReviewClient(bearerTokenCredentialKey("denied"), false)
+	for i := 0; i < 2; i++ {
+		allowed, err := IsAuthorized(client, "onepanel", "get", "onepanel.io", "workspaces", "jupyter")
+		assert.NotNil(t, err)
+		assert.False(t, allowed)
+	}
+	assert.Equal(t, 1, *reviews)
+}
+
+// TestIsAuthorized_NoCredentialKey makes sure clients without a credential key are never cached
+func TestIsAuthorized_NoCredentialKey(t *testing.T) {
+	client, reviews := newReviewClient("", true)
+	for i := 0; i < 2; i++ {
+		_, _ = IsAuthorized(client, "onepanel", "get", "onepanel.io", "workspaces", "jupyter")
+	}
+	assert.Equal(t, 2, *reviews)
+}
+
+// TestAuthorizationCache_Expiry makes sure decisions expire, and denials expire sooner than allowed decisions
+func TestAuthorizationCache_Expiry(t *testing.T) {
+	now := time.Now()
+	cache := newAuthorizationCache()
+	cache.now = func() time.Time { return now }
+
+	allowedKey := decisionKey{credential: "a", verb: "get"}
+	deniedKey := decisionKey{credential: "a", verb: "delete"}
+	cache.set(allowedKey, true)
+	cache.set(deniedKey, false)
+
+	now = now.Add(deniedDecisionTTL)
+	_, ok := cache.get(deniedKey)
+	assert.False(t, ok)
+	allowed, ok := cache.get(allowedKey)
+	assert.True(t, ok)
+	assert.True(t, allowed)
+
+	now = now.Add(allowedDecisionTTL)
+	_, ok = cache.get(allowedKey)
+	assert.False(t, ok)
+}
+
+// TestIdentityCredentialKey makes sure the group order doesn't matter, but the groups do
+func TestIdentityCredentialKey(t *testing.T) {
+	first := identityCredentialKey(&v1.Identity{Username: "user", Groups: []string{"a", "b"}})
+	second := identityCredentialKey(&v1.Identity{Username: "user", Groups: []string{"b", "a"}})
+	third := identityCredentialKey(&v1.Identity{Username: "user", Groups: []string{"a"}})
+
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first, third)
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+	v1 "github.com/onepanelio/core/pkg"
+	"k8s.io/client-go/rest"
+)
+
+// clientsetPoolSize is the number of credentials whose clientsets are kept for reuse
+const clientsetPoolSize = 256
+
+// clientFactory creates a client for each request.
+// Creating kubernetes and argo clientsets is expensive, so they are pooled by the credentials they use.
+// The base config is copied for each set of credentials and never modified, so concurrent requests can't see each other's credentials.
+type clientFactory struct {
+	baseConfig *v1.Config
+	pool       *lru.Cache
+}
+
+func newClientFactory(baseConfig *v1.Config) *clientFactory {
+	pool, err := lru.New(clientsetPoolSize)
+	if err != nil {
+		// Only happens if the size is not positive
+		panic(err)
+	}
+
+	return &clientFactory{
+		baseConfig: rest.CopyConfig(baseConfig),
+		pool:       pool,
+	}
+}
+
+// getClientsets returns the pooled clientsets for the credential key, creating them with configure if there are none.
+// configure receives a copy of the base config to add the credentials to.
+func (f *clientFactory) getClientsets(credentialKey string, configure func(config *v1.Config)) (*v1.Clientsets, error) {
+	if clientsets, ok := f.pool.Get(credentialKey); ok {
+		return clientsets.(*v1.Clientsets), nil
+	}
+
+	config := rest.CopyConfig(f.baseConfig)
+	configure(config)
+
+	clientsets, err := v1.NewClientsets(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Two requests may create clientsets for the same credentials at the same time, either can be kept
+	f.pool.Add(credentialKey, clientsets)
+
+	return clientsets, nil
+}
+
+// newBearerTokenClient returns a client that authenticates with the kubernetes token
+func (f *clientFactory) newBearerTokenClient(token string, db *v1.DB, sysConfig v1.SystemConfig) (*v1.Client, error) {
+	credentialKey := bearerTokenCredentialKey(token)
+	clientsets, err := f.getClientsets(credentialKey, func(config *v1.Config) {
+		config.BearerToken = token
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1.NewClientFromClientsets(clientsets, db, sysConfig)
+	client.CredentialKey = credentialKey
+
+	return client, nil
+}
+
+// newImpersonatingClient returns a client that uses the server's credentials to impersonate identity.
+// The service account needs RBAC permission to "impersonate" users and groups for this to work.
+func (f *clientFactory) newImpersonatingClient(identity *v1.Identity, db *v1.DB, sysConfig v1.SystemConfig) (*v1.Client, error) {
+	credentialKey := identityCredentialKey(identity)
+	clientsets, err := f.getClientsets(credentialKey, func(config *v1.Config) {
+		config.Impersonate = rest.ImpersonationConfig{
+			UserName: identity.Username,
+			Groups:   identity.Groups,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1.NewClientFromClientsets(clientsets, db, sysConfig)
+	client.Identity = identity
+	client.CredentialKey = credentialKey
+
+	return client, nil
+}
+
+// newServerClient returns a client with the server's own credentials.
+// It is only for requests that are authenticated by other means, like the secret url of a trigger.
+func (f *clientFactory) newServerClient(db *v1.DB, sysConfig v1.SystemConfig) (*v1.Client, error) {
+	clientsets, err := f.getClientsets(serverCredentialKey, func(config *v1.Config) {})
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1.NewClientFromClientsets(clientsets, db, sysConfig)
+	client.CredentialKey = serverCredentialKey
+
+	return client, nil
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/rest"
+)
+
+// newEchoAPIServer returns a kubernetes api server that answers every SelfSubjectAccessReview
+// with the credentials the request was made with in the reason.
+func newEchoAPIServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{
+				Allowed: true,
+				Reason:  r.Header.Get("Authorization") + "|" + r.Header.Get("Impersonate-User"),
+			},
+		}
+		review.Kind = "SelfSubjectAccessReview"
+		review.APIVersion = "authorization.k8s.io/v1"
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(review)
+	}))
+}
+
+// whoAmI returns the credentials the client's requests are made with
+func whoAmI(client *v1.Client) (string, error) {
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{})
+	if err != nil {
+		return "", err
+	}
+
+	return review.Status.Reason, nil
+}
+
+// TestGetClient_ConcurrentIdentities makes sure concurrent requests never get each other's credentials.
+// Run it with -race.
+func TestGetClient_ConcurrentIdentities(t *testing.T) {
+	server := newEchoAPIServer()
+	defer server.Close()
+
+	clients := newClientFactory(&rest.Config{Host: server.URL})
+	sysConfig := v1.SystemConfig{"ONEPANEL_DOMAIN": "onepanel.io"}
+
+	users := 20
+	requests := 10
+	wg := sync.WaitGroup{}
+	for user := 0; user < users; user++ {
+		for request := 0; request < requests; request++ {
+			wg.Add(2)
+
+			go func(user int) {
+				defer wg.Done()
+
+				token := fmt.Sprintf("token-%v", user)
+				ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+				ctx, err := getClient(ctx, clients, nil, sysConfig, nil)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				client := ctx.Value(ContextClientKey).(*v1.Client)
+				reason, err := whoAmI(client)
+				if assert.Nil(t, err) {
+					assert.Equal(t, "Bearer "+token+"|", reason)
+				}
+			}(user)
+
+			go func(user int) {
+				defer wg.Done()
+
+				identity := &v1.Identity{Username: fmt.Sprintf("user-%v", user)}
+				client, err := clients.newImpersonatingClient(identity, nil, sysConfig)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+
+				assert.Equal(t, identity.Username, client.Identity.Username)
+				reason, err := whoAmI(client)
+				if assert.Nil(t, err) {
+					assert.Contains(t, reason, "|"+identity.Username)
+				}
+			}(user)
+		}
+	}
+	wg.Wait()
+
+	assert.Equal(t, v1.SystemConfig{"ONEPANEL_DOMAIN": "onepanel.io"}, sysConfig)
+	assert.Equal(t, "", clients.baseConfig.BearerToken)
+}
+
+// TestClientFactory_Pool makes sure clientsets are reused for the same credentials only
+func TestClientFactory_Pool(t *testing.T) {
+	clients := newClientFactory(&rest.Config{Host: "https://localhost"})
+
+	first, err := clients.newBearerTokenClient("first", nil, nil)
+	assert.Nil(t, err)
+	again, err := clients.newBearerTokenClient("first", nil, nil)
+	assert.Nil(t, err)
+	second, err := clients.newBearerTokenClient("second", nil, nil)
+	assert.Nil(t, err)
+
+	// Each request gets its own client, but the clientsets are shared
+	assert.True(t, first != again)
+	assert.True(t, first.Interface == again.Interface)
+	assert.True(t, first.Interface != second.Interface)
+	assert.Equal(t, 2, clients.pool.Len())
+}
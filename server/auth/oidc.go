@@ -0,0 +1,363 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	v1 "github.com/onepanelio/core/pkg"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// jwksRefreshInterval is how long signing keys are cached before they are fetched again
+	jwksRefreshInterval = time.Hour
+	// jwksMinRefreshInterval limits how often an unknown key id can force a refresh of the signing keys
+	jwksMinRefreshInterval = time.Minute
+)
+
+// OIDCVerifier validates JWTs issued by an OpenID Connect provider using the provider's published signing keys.
+type OIDCVerifier struct {
+	config     *v1.OIDCConfig
+	httpClient *http.Client
+
+	mu        sync.RWMutex
+	jwksURL   string
+	keys      map[string]interface{}
+	fetchedAt time.Time
+	// refreshing is true while the keys are fetched, verifications with a cached key don't wait for it
+	refreshing bool
+}
+
+// NewOIDCVerifier creates a verifier for the provider described by config.
+// Signing keys are fetched lazily on the first verification.
+func NewOIDCVerifier(config *v1.OIDCConfig) *OIDCVerifier {
+	return &OIDCVerifier{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+		jwksURL: config.JWKSURL,
+	}
+}
+
+// newOIDCVerifierFromSystemConfig returns a verifier if oidc is configured, nil otherwise.
+// A malformed configuration is logged and treated as disabled so the server can still accept service account tokens.
+func newOIDCVerifierFromSystemConfig(sysConfig v1.SystemConfig) *OIDCVerifier {
+	config, err := sysConfig.OIDC()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Error": err.Error(),
+		}).Error("Invalid oidc configuration. OIDC authentication is disabled.")
+		return nil
+	}
+	if config == nil {
+		return nil
+	}
+
+	return NewOIDCVerifier(config)
+}
+
+// IsIssuedBy returns true if the token claims to be issued by the configured provider.
+// The signature is NOT checked, this is only used to decide how the token should be handled.
+// Kubernetes service account tokens are also JWTs, so we can't rely on the format alone.
+func (v *OIDCVerifier) IsIssuedBy(token string) bool {
+	if strings.Count(token, ".") != 2 {
+		return false
+	}
+
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return false
+	}
+
+	issuer, ok := claims["iss"].(string)
+
+	return ok && issuer == v.config.IssuerURL
+}
+
+// Verify checks the token signature, expiry, issuer and audience and returns the identity it was issued for.
+// Tokens without an expiry are rejected, they would be valid forever.
+func (v *OIDCVerifier) Verify(token string) (*v1.Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, v.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := claims["exp"]; !ok {
+		return nil, errors.New("token has no expiry")
+	}
+
+	if !claims.VerifyIssuer(v.config.IssuerURL, true) {
+		return nil, errors.New("token has an unexpected issuer")
+	}
+
+	if !hasAudience(claims["aud"], v.config.ClientID) {
+		return nil, errors.New("token was not issued for this client")
+	}
+
+	return v.identityFromClaims(claims)
+}
+
+// isSystemName returns true if the user or group name is reserved by kubernetes, such as system:masters.
+// The identity is impersonated with the credentials of the server, so the provider must not be able to claim these.
+func isSystemName(name string) bool {
+	return strings.HasPrefix(name, "system:")
+}
+
+func (v *OIDCVerifier) identityFromClaims(claims jwt.MapClaims) (*v1.Identity, error) {
+	username, ok := claims[v.config.UsernameClaim].(string)
+	if !ok || username == "" {
+		return nil, fmt.Errorf("token is missing the '%v' claim", v.config.UsernameClaim)
+	}
+
+	identity := &v1.Identity{
+		Username: v.config.UsernamePrefix + username,
+		Groups:   make([]string, 0),
+	}
+
+	if isSystemName(identity.Username) {
+		return nil, fmt.Errorf("token is for the reserved user '%v'", identity.Username)
+	}
+
+	switch groups := claims[v.config.GroupsClaim].(type) {
+	case string:
+		identity.Groups = append(identity.Groups, v.config.GroupsPrefix+groups)
+	case []interface{}:
+		for _, group := range groups {
+			if groupName, ok := group.(string); ok {
+				identity.Groups = append(identity.Groups, v.config.GroupsPrefix+groupName)
+			}
+		}
+	}
+
+	for _, group := range identity.Groups {
+		if isSystemName(group) {
+			return nil, fmt.Errorf("token is for the reserved group '%v'", group)
+		}
+	}
+
+	return identity, nil
+}
+
+// hasAudience returns true if the aud claim, which may be a string or a list of strings, contains clientID
+func hasAudience(aud interface{}, clientID string) bool {
+	switch audience := aud.(type) {
+	case string:
+		return audience == clientID
+	case []interface{}:
+		for _, item := range audience {
+			if value, ok := item.(string); ok && value == clientID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// keyFunc returns the public key used to sign the token.
+// Only asymmetric algorithms are accepted, so a token can't be signed with the public key as a HMAC secret.
+func (v *OIDCVerifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+	default:
+		return nil, fmt.Errorf("unexpected signing method '%v'", token.Header["alg"])
+	}
+
+	kid, _ := token.Header["kid"].(string)
+
+	return v.getKey(kid)
+}
+
+// getKey returns the signing key with the kid. Keys are refreshed if they are stale or if the kid is unknown,
+// since the provider may have rotated its keys. A stale key is used while another verification refreshes the keys.
+func (v *OIDCVerifier) getKey(kid string) (interface{}, error) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	stale := time.Since(v.fetchedAt) > jwksRefreshInterval
+	canRefresh := time.Since(v.fetchedAt) > jwksMinRefreshInterval
+	v.mu.RUnlock()
+
+	if ok && !stale {
+		return key, nil
+	}
+
+	if !ok && !canRefresh {
+		return nil, fmt.Errorf("unknown signing key '%v'", kid)
+	}
+
+	v.mu.Lock()
+	if ok && v.refreshing {
+		v.mu.Unlock()
+		return key, nil
+	}
+	v.refreshing = true
+	v.mu.Unlock()
+
+	err := v.refreshKeys()
+
+	v.mu.Lock()
+	v.refreshing = false
+	v.mu.Unlock()
+
+	if err != nil {
+		// Keep using the cached key if the provider is temporarily unavailable
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	key, ok = v.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key '%v'", kid)
+	}
+
+	return key, nil
+}
+
+type openIDConfiguration struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+func (v *OIDCVerifier) getJSON(url string, target interface{}) error {
+	res, err := v.httpClient.Get(url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %v from '%v'", res.StatusCode, url)
+	}
+
+	return json.NewDecoder(res.Body).Decode(target)
+}
+
+// refreshKeys loads the signing keys from the provider, discovering the jwks url first if needed.
+// The provider is called without holding the lock, so a slow provider doesn't hold up verifications with cached keys.
+func (v *OIDCVerifier) refreshKeys() error {
+	v.mu.RLock()
+	jwksURL := v.jwksURL
+	v.mu.RUnlock()
+
+	if jwksURL == "" {
+		discovery := &openIDConfiguration{}
+		discoveryURL := strings.TrimSuffix(v.config.IssuerURL, "/") + "/.well-known/openid-configuration"
+		if err := v.getJSON(discoveryURL, discovery); err != nil {
+			return err
+		}
+		if discovery.Issuer != v.config.IssuerURL {
+			return fmt.Errorf("oidc discovery issuer '%v' does not match '%v'", discovery.Issuer, v.config.IssuerURL)
+		}
+		jwksURL = discovery.JWKSURI
+	}
+
+	keySet := &jsonWebKeySet{}
+	if err := v.getJSON(jwksURL, keySet); err != nil {
+		return err
+	}
+
+	keys := make(map[string]interface{})
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Kid":   jwk.Kid,
+				"Error": err.Error(),
+			}).Warn("Skipping unsupported oidc signing key.")
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.jwksURL = jwksURL
+	v.keys = keys
+	v.fetchedAt = time.Now()
+
+	return nil
+}
+
+// publicKey converts the json web key into a *rsa.PublicKey or *ecdsa.PublicKey
+func (k *jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve '%v'", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type '%v'", k.Kty)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
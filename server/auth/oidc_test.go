@@ -0,0 +1,312 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockIssuer is a minimal OpenID Connect provider that serves discovery and signing keys
+type mockIssuer struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+	// release blocks requests for the signing keys until it is closed, if it is set
+	release chan struct{}
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := &mockIssuer{
+		key: key,
+		kid: "test-key",
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer.server.URL,
+			"jwks_uri": issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		if issuer.release != nil {
+			<-issuer.release
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kid": issuer.kid,
+					"kty": "RSA",
+					"use": "sig",
+					"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+				},
+			},
+		})
+	})
+	issuer.server = httptest.NewServer(mux)
+
+	return issuer
+}
+
+func (m *mockIssuer) sign(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.kid
+
+	signed, err := token.SignedString(m.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func (m *mockIssuer) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":    m.server.URL,
+		"aud":    "onepanel",
+		"sub":    "1234",
+		"email":  "user@onepanel.io",
+		"groups": []string{"admins", "developers"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+		"iat":    time.Now().Unix(),
+	}
+}
+
+func (m *mockIssuer) verifier() *OIDCVerifier {
+	return NewOIDCVerifier(&v1.OIDCConfig{
+		IssuerURL:     m.server.URL,
+		ClientID:      "onepanel",
+		UsernameClaim: "email",
+		GroupsClaim:   "groups",
+		GroupsPrefix:  "oidc:",
+	})
+}
+
+// TestOIDCVerifier_Verify makes sure a valid token is mapped to the user and groups in its claims
+func TestOIDCVerifier_Verify(t *testing.T) {
+	issuer := newMockIssuer(t)
+	defer issuer.server.Close()
+
+	verifier := issuer.verifier()
+	token := issuer.sign(t, issuer.claims())
+
+	assert.True(t, verifier.IsIssuedBy(token))
+
+	identity, err := verifier.Verify(token)
+	assert.Nil(t, err)
+	assert.Equal(t, "user@onepanel.io", identity.Username)
+	assert.Equal(t, []string{"oidc:admins", "oidc:developers"}, identity.Groups)
+}
+
+// TestOIDCVerifier_Verify_AudienceList makes sure aud can be a list of clients
+func TestOIDCVerifier_Verify_AudienceList(t *testing.T) {
+	issuer := newMockIssuer(t)
+	defer issuer.server.Close()
+
+	claims := issuer.claims()
+	claims["aud"] = []string{"other", "onepanel"}
+
+	_, err := issuer.verifier().Verify(issuer.sign(t, claims))
+	assert.Nil(t, err)
+}
+
+// TestOIDCVerifier_Verify_Invalid makes sure tokens that shouldn't be trusted are rejected
+func TestOIDCVerifier_Verify_Invalid(t *testing.T) {
+	issuer := newMockIssuer(t)
+	defer issuer.server.Close()
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		token func() string
+	}{
+		{
+			name: "expired",
+			token: func() string {
+				claims := issuer.claims()
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				return issuer.sign(t, claims)
+			},
+		},
+		{
+			name: "wrong audience",
+			token: func() string {
+				claims := issuer.claims()
+				claims["aud"] = "someone-else"
+				return issuer.sign(t, claims)
+			},
+		},
+		{
+			name: "wrong issuer",
+			token: func() string {
+				claims := issuer.claims()
+				claims["iss"] = "https://evil.example.com"
+				return issuer.sign(t, claims)
+			},
+		},
+		{
+			name: "missing expiry",
+			token: func() string {
+				claims := issuer.claims()
+				delete(claims, "exp")
+				return issuer.sign(t, claims)
+			},
+		},
+		{
+			name: "system username",
+			token: func() string {
+				claims := issuer.claims()
+				claims["email"] = "system:admin"
+				return issuer.sign(t, claims)
+			},
+		},
+		{
+			name: "missing username",
+			token: func() string {
+				claims := issuer.claims()
+				delete(claims, "email")
+				return issuer.sign(t, claims)
+			},
+		},
+		{
+			name: "wrong signing key",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodRS256, issuer.claims())
+				token.Header["kid"] = issuer.kid
+				signed, _ := token.SignedString(otherKey)
+				return signed
+			},
+		},
+		{
+			name: "hmac signed with public key",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, issuer.claims())
+				token.Header["kid"] = issuer.kid
+				signed, _ := token.SignedString(issuer.key.PublicKey.N.Bytes())
+				return signed
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := issuer.verifier().Verify(tt.token())
+			assert.NotNil(t, err)
+			assert.Nil(t, identity)
+		})
+	}
+}
+
+// TestOIDCVerifier_Verify_SystemGroup makes sure the provider can't claim kubernetes system groups when there is no prefix
+func TestOIDCVerifier_Verify_SystemGroup(t *testing.T) {
+	issuer := newMockIssuer(t)
+	defer issuer.server.Close()
+
+	verifier := issuer.verifier()
+	verifier.config.GroupsPrefix = ""
+
+	claims := issuer.claims()
+	claims["groups"] = []string{"developers", "system:masters"}
+
+	identity, err := verifier.Verify(issuer.sign(t, claims))
+	assert.NotNil(t, err)
+	assert.Nil(t, identity)
+}
+
+// TestOIDCVerifier_RefreshKeys makes sure verifications with cached keys don't wait for a slow provider
+func TestOIDCVerifier_RefreshKeys(t *testing.T) {
+	issuer := newMockIssuer(t)
+	defer issuer.server.Close()
+
+	verifier := issuer.verifier()
+	token := issuer.sign(t, issuer.claims())
+	_, err := verifier.Verify(token)
+	assert.Nil(t, err)
+
+	issuer.release = make(chan struct{})
+
+	verifier.mu.Lock()
+	verifier.fetchedAt = time.Now().Add(-2 * jwksRefreshInterval)
+	verifier.mu.Unlock()
+
+	refreshed := make(chan error)
+	go func() {
+		_, err := verifier.Verify(token)
+		refreshed <- err
+	}()
+
+	// wait for the refresh to start, it is blocked on the provider
+	for {
+		verifier.mu.RLock()
+		refreshing := verifier.refreshing
+		verifier.mu.RUnlock()
+		if refreshing {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	_, err = verifier.Verify(token)
+	assert.Nil(t, err)
+
+	close(issuer.release)
+	assert.Nil(t, <-refreshed)
+}
+
+// TestOIDCVerifier_IsIssuedBy makes sure only tokens from the configured issuer are treated as oidc tokens
+func TestOIDCVerifier_IsIssuedBy(t *testing.T) {
+	issuer := newMockIssuer(t)
+	defer issuer.server.Close()
+
+	verifier := issuer.verifier()
+
+	claims := issuer.claims()
+	claims["iss"] = "kubernetes/serviceaccount"
+	serviceAccountToken := issuer.sign(t, claims)
+
+	assert.False(t, verifier.IsIssuedBy(serviceAccountToken))
+	assert.False(t, verifier.IsIssuedBy("not-a-jwt"))
+	assert.True(t, verifier.IsIssuedBy(issuer.sign(t, issuer.claims())))
+}
+
+// TestSystemConfig_OIDC makes sure oidc config is parsed and defaults are applied
+func TestSystemConfig_OIDC(t *testing.T) {
+	sysConfig := v1.SystemConfig{}
+	config, err := sysConfig.OIDC()
+	assert.Nil(t, err)
+	assert.Nil(t, config)
+
+	sysConfig["oidc"] = `
+issuerURL: https://accounts.google.com
+clientID: onepanel
+`
+	config, err = sysConfig.OIDC()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://accounts.google.com", config.IssuerURL)
+	assert.Equal(t, "sub", config.UsernameClaim)
+	assert.Equal(t, "groups", config.GroupsClaim)
+
+	sysConfig["oidc"] = `clientID: onepanel`
+	_, err = sysConfig.OIDC()
+	assert.NotNil(t, err)
+}
@@ -66,9 +66,10 @@ func (a *AuthServer) IsValidToken(ctx context.Context, req *api.IsValidTokenRequ
 	if err != nil {
 		return
 	}
+	token, _ := auth.BearerToken(ctx)
 	res = &api.IsValidTokenResponse{
 		Domain: config["ONEPANEL_DOMAIN"],
-		Token:  config["token"],
+		Token:  token,
 	}
 
 	return res, nil
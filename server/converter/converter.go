@@ -192,3 +192,476 @@ func WorkspaceStatisticsReportToAPI(report *v1.WorkspaceStatisticReport) *api.Wo
 
 	return stats
 }
+
+// APITokenToAPI converts a v1.APIToken to an api.Token. The secret hash is never included.
+func APITokenToAPI(token *v1.APIToken) *api.Token {
+	if token == nil {
+		return nil
+	}
+
+	return &api.Token{
+		Uid:        token.UID,
+		Name:       token.Name,
+		ReadOnly:   token.ReadOnly,
+		Namespace:  token.Namespace,
+		ExpiresAt:  TimestampToAPIString(token.ExpiresAt),
+		LastUsedAt: TimestampToAPIString(token.LastUsedAt),
+		RevokedAt:  TimestampToAPIString(token.RevokedAt),
+		CreatedAt:  TimestampToAPIString(&token.CreatedAt),
+	}
+}
+
+// AuditEventToAPI converts a v1.AuditEvent to an api.AuditEvent
+func AuditEventToAPI(event *v1.AuditEvent) *api.AuditEvent {
+	if event == nil {
+		return nil
+	}
+
+	return &api.AuditEvent{
+		Username:    event.Username,
+		Method:      event.Method,
+		Namespace:   event.Namespace,
+		ResourceUid: event.ResourceUID,
+		Request:     event.Request,
+		Code:        event.Code,
+		LatencyMs:   event.LatencyMS,
+		CreatedAt:   TimestampToAPIString(&event.CreatedAt),
+	}
+}
+
+func workflowExecutionNodeIOToAPI(io *v1.WorkflowExecutionNodeIO) *api.WorkflowExecutionNodeIO {
+	if io == nil {
+		return nil
+	}
+
+	result := &api.WorkflowExecutionNodeIO{
+		Parameters: make([]*api.Parameter, 0),
+	}
+
+	for _, parameter := range io.Parameters {
+		result.Parameters = append(result.Parameters, &api.Parameter{
+			Name:  parameter.Name,
+			Value: parameter.Value,
+		})
+	}
+
+	result.Artifacts = workflowExecutionNodeArtifactsToAPI(io.Artifacts)
+
+	return result
+}
+
+func workflowExecutionNodeArtifactsToAPI(artifacts []*v1.WorkflowExecutionNodeArtifact) []*api.WorkflowExecutionNodeArtifact {
+	result := make([]*api.WorkflowExecutionNodeArtifact, 0)
+	for _, artifact := range artifacts {
+		result = append(result, &api.WorkflowExecutionNodeArtifact{
+			Name: artifact.Name,
+			Path: artifact.Path,
+			Key:  artifact.Key,
+		})
+	}
+
+	return result
+}
+
+// WorkflowExecutionGraphToAPI converts a v1.WorkflowExecutionGraph to an api.WorkflowExecutionGraph
+func WorkflowExecutionGraphToAPI(graph *v1.WorkflowExecutionGraph) *api.WorkflowExecutionGraph {
+	if graph == nil {
+		return nil
+	}
+
+	result := &api.WorkflowExecutionGraph{
+		Phase:      string(graph.Phase),
+		Message:    graph.Message,
+		StartedAt:  TimestampToAPIString(graph.StartedAt),
+		FinishedAt: TimestampToAPIString(graph.FinishedAt),
+		Nodes:      make([]*api.WorkflowExecutionNode, 0),
+	}
+
+	for _, node := range graph.Nodes {
+		result.Nodes = append(result.Nodes, &api.WorkflowExecutionNode{
+			Id:           node.ID,
+			Name:         node.Name,
+			DisplayName:  node.DisplayName,
+			Type:         string(node.Type),
+			TemplateName: node.TemplateName,
+			Phase:        string(node.Phase),
+			BoundaryId:   node.BoundaryID,
+			Message:      node.Message,
+			StartedAt:    TimestampToAPIString(node.StartedAt),
+			FinishedAt:   TimestampToAPIString(node.FinishedAt),
+			PodName:      node.PodName,
+			Children:     node.Children,
+			Inputs:       workflowExecutionNodeIOToAPI(node.Inputs),
+			Outputs:      workflowExecutionNodeIOToAPI(node.Outputs),
+		})
+	}
+
+	return result
+}
+
+// BulkWorkflowExecutionResultsToAPI converts the results of a bulk operation to the api response
+func BulkWorkflowExecutionResultsToAPI(results []*v1.BulkWorkflowExecutionResult, dryRun bool) *api.BulkWorkflowExecutionsResponse {
+	res := &api.BulkWorkflowExecutionsResponse{
+		DryRun: dryRun,
+	}
+
+	for _, result := range results {
+		res.Results = append(res.Results, &api.BulkWorkflowExecutionResult{
+			Uid:     result.UID,
+			Name:    result.Name,
+			Phase:   string(result.Phase),
+			Status:  result.Status,
+			Message: result.Message,
+		})
+	}
+
+	return res
+}
+
+// RetentionReportToAPI converts the workflow executions the retention policies archive to the api response
+func RetentionReportToAPI(items []*v1.RetentionReportItem) *api.GetWorkflowExecutionRetentionReportResponse {
+	res := &api.GetWorkflowExecutionRetentionReportResponse{}
+
+	for _, item := range items {
+		res.Items = append(res.Items, &api.RetentionReportItem{
+			Uid:                 item.UID,
+			Name:                item.Name,
+			WorkflowTemplateUid: item.WorkflowTemplateUID,
+			Phase:               string(item.Phase),
+			CreatedAt:           TimestampToAPIString(&item.CreatedAt),
+			FinishedAt:          TimestampToAPIString(&item.FinishedAt),
+			Policy:              int32(item.Policy),
+			Reason:              item.Reason,
+		})
+	}
+
+	return res
+}
+
+func comparisonRowsToAPI(rows []*v1.ComparisonRow) []*api.ComparisonRow {
+	result := make([]*api.ComparisonRow, 0, len(rows))
+	for _, row := range rows {
+		apiRow := &api.ComparisonRow{
+			Step:      row.Step,
+			Name:      row.Name,
+			Different: row.Different,
+		}
+		for _, value := range row.Values {
+			if value == nil {
+				apiRow.Values = append(apiRow.Values, &api.ComparisonValue{Missing: true})
+				continue
+			}
+			apiRow.Values = append(apiRow.Values, &api.ComparisonValue{Value: *value})
+		}
+		result = append(result, apiRow)
+	}
+
+	return result
+}
+
+// WorkflowExecutionComparisonToAPI converts a v1.WorkflowExecutionComparison to an api.WorkflowExecutionComparison
+func WorkflowExecutionComparisonToAPI(comparison *v1.WorkflowExecutionComparison) *api.WorkflowExecutionComparison {
+	result := &api.WorkflowExecutionComparison{
+		Parameters: comparisonRowsToAPI(comparison.Parameters),
+		Metrics:    comparisonRowsToAPI(comparison.Metrics),
+	}
+
+	for _, execution := range comparison.Executions {
+		result.Executions = append(result.Executions, &api.ComparedWorkflowExecution{
+			Uid:                       execution.UID,
+			Name:                      execution.Name,
+			Phase:                     string(execution.Phase),
+			WorkflowTemplateUid:       execution.WorkflowTemplateUID,
+			WorkflowTemplateName:      execution.WorkflowTemplateName,
+			WorkflowTemplateVersion:   execution.WorkflowTemplateVersion,
+			WorkflowTemplateVersionId: execution.WorkflowTemplateVersionID,
+			StartedAt:                 TimestampToAPIString(execution.StartedAt),
+			FinishedAt:                TimestampToAPIString(execution.FinishedAt),
+			DurationSeconds:           execution.Duration.Seconds(),
+		})
+	}
+
+	for _, step := range comparison.Steps {
+		apiStep := &api.ComparedStep{
+			Name:      step.Name,
+			Different: step.Different,
+		}
+		for _, value := range step.Values {
+			if value == nil {
+				apiStep.Values = append(apiStep.Values, &api.ComparedStepValue{Missing: true})
+				continue
+			}
+			apiStep.Values = append(apiStep.Values, &api.ComparedStepValue{
+				Phase:           string(value.Phase),
+				StartedAt:       TimestampToAPIString(value.StartedAt),
+				FinishedAt:      TimestampToAPIString(value.FinishedAt),
+				DurationSeconds: value.Duration.Seconds(),
+				Artifacts:       workflowExecutionNodeArtifactsToAPI(value.Artifacts),
+			})
+		}
+		result.Steps = append(result.Steps, apiStep)
+	}
+
+	return result
+}
+
+// APIMetricPointsToInternal converts the logged api metric points
+func APIMetricPointsToInternal(points []*api.MetricPoint) []*v1.MetricPoint {
+	result := make([]*v1.MetricPoint, 0, len(points))
+	for _, point := range points {
+		result = append(result, &v1.MetricPoint{
+			Name:  point.Name,
+			Step:  point.Step,
+			Value: point.Value,
+		})
+	}
+
+	return result
+}
+
+// MetricSeriesToAPI converts metric series to the api response
+func MetricSeriesToAPI(series []*v1.MetricSeries) *api.GetMetricSeriesResponse {
+	res := &api.GetMetricSeriesResponse{}
+
+	for _, s := range series {
+		apiSeries := &api.MetricSeries{
+			NodeId: s.NodeID,
+			Name:   s.Name,
+		}
+		for _, point := range s.Points {
+			apiSeries.Points = append(apiSeries.Points, &api.MetricSeriesPoint{
+				Step:      point.Step,
+				Value:     point.Value,
+				Min:       point.Min,
+				Max:       point.Max,
+				CreatedAt: TimestampToAPIString(&point.CreatedAt),
+			})
+		}
+		res.Series = append(res.Series, apiSeries)
+	}
+
+	return res
+}
+
+// APISweepSpecToInternal converts the api spec of a sweep
+func APISweepSpecToInternal(spec *api.SweepSpec) v1.SweepSpec {
+	result := v1.SweepSpec{}
+	if spec == nil {
+		return result
+	}
+
+	result.Algorithm = spec.Algorithm
+	result.MaxRuns = int(spec.MaxRuns)
+	result.Parallelism = int(spec.Parallelism)
+	result.Seed = spec.Seed
+	if spec.Objective != nil {
+		result.Objective = &v1.SweepObjective{
+			Metric: spec.Objective.Metric,
+			Goal:   spec.Objective.Goal,
+		}
+	}
+
+	for _, parameter := range spec.Parameters {
+		sweepParameter := v1.SweepParameter{
+			Name:   parameter.Name,
+			Values: parameter.Values,
+		}
+		if parameter.Range != nil {
+			min, max := parameter.Range.Min, parameter.Range.Max
+			sweepParameter.Min = &min
+			sweepParameter.Max = &max
+			if parameter.Range.Step != 0 {
+				step := parameter.Range.Step
+				sweepParameter.Step = &step
+			}
+			sweepParameter.Integer = parameter.Range.Integer
+		}
+		result.Parameters = append(result.Parameters, sweepParameter)
+	}
+
+	return result
+}
+
+// sweepSpecToAPI converts the spec of a sweep to the api
+func sweepSpecToAPI(spec *v1.SweepSpec) *api.SweepSpec {
+	result := &api.SweepSpec{
+		Algorithm:   spec.Algorithm,
+		MaxRuns:     int32(spec.MaxRuns),
+		Parallelism: int32(spec.Parallelism),
+		Seed:        spec.Seed,
+	}
+	if spec.Objective != nil {
+		result.Objective = &api.SweepObjective{
+			Metric: spec.Objective.Metric,
+			Goal:   spec.Objective.Goal,
+		}
+	}
+
+	for _, parameter := range spec.Parameters {
+		apiParameter := &api.SweepParameter{
+			Name:   parameter.Name,
+			Values: parameter.Values,
+		}
+		if parameter.Min != nil && parameter.Max != nil {
+			apiParameter.Range = &api.SweepRange{
+				Min:     *parameter.Min,
+				Max:     *parameter.Max,
+				Integer: parameter.Integer,
+			}
+			if parameter.Step != nil {
+				apiParameter.Range.Step = *parameter.Step
+			}
+		}
+		result.Parameters = append(result.Parameters, apiParameter)
+	}
+
+	return result
+}
+
+// SweepToAPI converts a sweep to the api, the runs are only included if withRuns is true
+func SweepToAPI(sweep *v1.Sweep, withRuns bool) *api.Sweep {
+	result := &api.Sweep{
+		Uid:        sweep.UID,
+		Name:       sweep.Name,
+		Phase:      sweep.Phase,
+		Message:    sweep.Message,
+		Spec:       sweepSpecToAPI(&sweep.Spec),
+		Launched:   int32(sweep.Launched),
+		Active:     int32(sweep.Active),
+		Succeeded:  int32(sweep.Succeeded),
+		Failed:     int32(sweep.Failed),
+		CreatedAt:  TimestampToAPIString(&sweep.CreatedAt),
+		FinishedAt: TimestampToAPIString(sweep.FinishedAt),
+	}
+
+	if sweep.WorkflowTemplate != nil {
+		result.WorkflowTemplate = &api.WorkflowTemplate{
+			Uid:     sweep.WorkflowTemplate.UID,
+			Name:    sweep.WorkflowTemplate.Name,
+			Version: sweep.WorkflowTemplate.Version,
+		}
+	}
+
+	if sweep.BestWorkflowExecutionUID != nil && sweep.BestValue != nil {
+		result.BestWorkflowExecutionUid = *sweep.BestWorkflowExecutionUID
+		result.BestValue = *sweep.BestValue
+	}
+
+	if withRuns {
+		for _, run := range sweep.Runs {
+			result.Runs = append(result.Runs, &api.SweepRun{Parameters: ParametersToAPI(run)})
+		}
+	}
+
+	return result
+}
+
+// NotificationSubscriptionToAPI converts a subscription to the api, the secret is only included if withSecret is true
+func NotificationSubscriptionToAPI(subscription *v1.NotificationSubscription, withSecret bool) *api.NotificationSubscription {
+	result := &api.NotificationSubscription{
+		Uid:       subscription.UID,
+		Name:      subscription.Name,
+		Url:       subscription.URL,
+		Format:    subscription.Format,
+		Events:    subscription.Events,
+		Labels:    MappingToKeyValue(subscription.Labels),
+		CreatedAt: TimestampToAPIString(&subscription.CreatedAt),
+	}
+
+	if withSecret {
+		result.Secret = subscription.Secret
+	}
+
+	return result
+}
+
+// NotificationDeliveryToAPI converts a delivery of the delivery log to the api
+func NotificationDeliveryToAPI(delivery *v1.NotificationDelivery) *api.NotificationDelivery {
+	result := &api.NotificationDelivery{
+		Uid:           delivery.UID,
+		Event:         delivery.Event,
+		ResourceUid:   delivery.ResourceUID,
+		Payload:       delivery.Payload,
+		Status:        delivery.Status,
+		Attempts:      int32(delivery.Attempts),
+		Error:         delivery.Error,
+		NextAttemptAt: TimestampToAPIString(delivery.NextAttemptAt),
+		CreatedAt:     TimestampToAPIString(&delivery.CreatedAt),
+		DeliveredAt:   TimestampToAPIString(delivery.DeliveredAt),
+	}
+
+	if delivery.ResponseCode != nil {
+		result.ResponseCode = int32(*delivery.ResponseCode)
+	}
+
+	return result
+}
+
+// APITriggerParametersToInternal converts the parameter mappings of an api trigger
+func APITriggerParametersToInternal(parameters []*api.TriggerParameter) []v1.TriggerParameter {
+	result := make([]v1.TriggerParameter, 0, len(parameters))
+	for _, parameter := range parameters {
+		mapping := v1.TriggerParameter{
+			Name: parameter.Name,
+			Path: parameter.Path,
+		}
+		if parameter.HasDefault {
+			defaultValue := parameter.DefaultValue
+			mapping.Default = &defaultValue
+		}
+		result = append(result, mapping)
+	}
+
+	return result
+}
+
+// TriggerToAPI converts a trigger to the api, its secrets are never included
+func TriggerToAPI(trigger *v1.Trigger) *api.Trigger {
+	result := &api.Trigger{
+		Uid:            trigger.UID,
+		Name:           trigger.Name,
+		Labels:         MappingToKeyValue(trigger.Labels),
+		CreatedAt:      TimestampToAPIString(&trigger.CreatedAt),
+		Type:           trigger.Type,
+		ArtifactPrefix: trigger.ArtifactPrefix,
+	}
+
+	if trigger.WorkflowTemplate != nil {
+		result.WorkflowTemplate = &api.WorkflowTemplate{
+			Uid:     trigger.WorkflowTemplate.UID,
+			Name:    trigger.WorkflowTemplate.Name,
+			Version: trigger.WorkflowTemplate.Version,
+		}
+	}
+
+	for _, parameter := range trigger.Parameters {
+		apiParameter := &api.TriggerParameter{
+			Name: parameter.Name,
+			Path: parameter.Path,
+		}
+		if parameter.Default != nil {
+			apiParameter.DefaultValue = *parameter.Default
+			apiParameter.HasDefault = true
+		}
+		result.Parameters = append(result.Parameters, apiParameter)
+	}
+
+	return result
+}
+
+// TriggerInvocationToAPI converts an invocation of a trigger to the api
+func TriggerInvocationToAPI(invocation *v1.TriggerInvocation) *api.TriggerInvocation {
+	result := &api.TriggerInvocation{
+		Status:     invocation.Status,
+		Error:      invocation.Error,
+		Payload:    invocation.Payload,
+		Parameters: ParametersToAPI(invocation.Parameters),
+		CreatedAt:  TimestampToAPIString(&invocation.CreatedAt),
+	}
+
+	if invocation.WorkflowExecutionUID != nil {
+		result.WorkflowExecutionUid = *invocation.WorkflowExecutionUID
+	}
+
+	return result
+}
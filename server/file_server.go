@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/server/auth"
+	"google.golang.org/grpc/codes"
+)
+
+// FileServer contains actions for the files in the artifact repositories of namespaces.
+// Access is granted with RBAC rules for the "files" resource in the "onepanel.io" group.
+type FileServer struct{}
+
+// NewFileServer creates a new FileServer
+func NewFileServer() *FileServer {
+	return &FileServer{}
+}
+
+func apiFile(file *v1.File) *api.File {
+	return &api.File{
+		Path:         file.Path,
+		Name:         file.Name,
+		Extension:    file.Extension,
+		Directory:    file.Directory,
+		Size:         file.Size,
+		ContentType:  file.ContentType,
+		LastModified: file.LastModified.UTC().Format(time.RFC3339),
+	}
+}
+
+// artifactUpload is a file of an UploadArtifact stream, its chunks are written to the pipe while the client uploads them
+type artifactUpload struct {
+	writer *io.PipeWriter
+	done   chan struct{}
+	file   *v1.File
+	err    error
+}
+
+// startArtifactUpload starts uploading the file the request starts
+func startArtifactUpload(client *v1.Client, req *api.UploadArtifactRequest) *artifactUpload {
+	reader, writer := io.Pipe()
+	upload := &artifactUpload{
+		writer: writer,
+		done:   make(chan struct{}),
+	}
+
+	size := req.Size
+	if size == 0 {
+		size = -1
+	}
+
+	go func() {
+		defer close(upload.done)
+		upload.file, upload.err = client.UploadArtifact(req.Namespace, req.Key, reader, size, req.ContentType)
+		// Unblocks the stream if the upload failed before reading everything
+		reader.CloseWithError(upload.err)
+	}()
+
+	return upload
+}
+
+// finish waits for the upload to complete, closing it with err if it is not nil
+func (u *artifactUpload) finish(err error) (*v1.File, error) {
+	u.writer.CloseWithError(err)
+	<-u.done
+
+	if u.err != nil {
+		return nil, u.err
+	}
+
+	return u.file, err
+}
+
+// UploadArtifact uploads the files of the stream to the artifact repository of the namespace.
+// The files are uploaded one at a time, the ones uploaded before an error are kept.
+func (s *FileServer) UploadArtifact(stream api.FileService_UploadArtifactServer) error {
+	client := getClient(stream.Context())
+
+	namespace := ""
+	files := make([]*api.File, 0)
+	var upload *artifactUpload
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if upload != nil {
+				upload.finish(err)
+			}
+			return err
+		}
+
+		if req.Key != "" {
+			if upload != nil {
+				file, err := upload.finish(nil)
+				if err != nil {
+					return err
+				}
+				files = append(files, apiFile(file))
+				upload = nil
+			}
+
+			if namespace == "" {
+				allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "onepanel.io", "files", "")
+				if err != nil || !allowed {
+					return err
+				}
+				namespace = req.Namespace
+			}
+			if req.Namespace != namespace {
+				return util.NewUserError(codes.InvalidArgument, "Files must be uploaded to one namespace.")
+			}
+
+			upload = startArtifactUpload(client, req)
+		}
+
+		if upload == nil {
+			return util.NewUserError(codes.InvalidArgument, "Key is required.")
+		}
+
+		if len(req.Chunk) > 0 {
+			if _, err := upload.writer.Write(req.Chunk); err != nil {
+				_, err = upload.finish(err)
+				return err
+			}
+		}
+	}
+
+	if upload != nil {
+		file, err := upload.finish(nil)
+		if err != nil {
+			return err
+		}
+		files = append(files, apiFile(file))
+	}
+
+	return stream.SendAndClose(&api.UploadArtifactResponse{
+		Files: files,
+	})
+}
+
+// GetArtifactURL returns a presigned url that downloads or uploads a file of the artifact repository of the namespace.
+// Downloading needs the "get" verb, uploading the "create" verb.
+func (s *FileServer) GetArtifactURL(ctx context.Context, req *api.GetArtifactURLRequest) (*api.GetArtifactURLResponse, error) {
+	method := strings.ToUpper(req.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+	verb := "get"
+	if method == http.MethodPut {
+		verb = "create"
+	}
+
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, verb, "onepanel.io", "files", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	url, expiresAt, err := client.GetArtifactURL(req.Namespace, req.Key, method, time.Duration(req.ExpiresIn)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetArtifactURLResponse{
+		Url:       url,
+		Method:    method,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// CreateArtifactDirectory creates an empty directory in the artifact repository of the namespace
+func (s *FileServer) CreateArtifactDirectory(ctx context.Context, req *api.CreateArtifactDirectoryRequest) (*api.File, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "onepanel.io", "files", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	file, err := client.CreateArtifactDirectory(req.Namespace, req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiFile(file), nil
+}
+
+// DeleteArtifacts deletes a file, or a directory and everything in it, from the artifact repository of the namespace
+func (s *FileServer) DeleteArtifacts(ctx context.Context, req *api.DeleteArtifactsRequest) (*api.DeleteArtifactsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "onepanel.io", "files", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	count, err := client.DeleteArtifacts(req.Namespace, req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DeleteArtifactsResponse{
+		Count: int32(count),
+	}, nil
+}
+
+// MoveArtifacts moves a file, or a directory and everything in it, within the artifact repository of the namespace
+func (s *FileServer) MoveArtifacts(ctx context.Context, req *api.MoveArtifactsRequest) (*api.MoveArtifactsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "files", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	count, err := client.MoveArtifacts(req.Namespace, req.Source, req.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.MoveArtifactsResponse{
+		Count: int32(count),
+	}, nil
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/onepanelio/core/api"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+)
+
+// fileUploadChunkSize is the size of the chunks multipart uploads are streamed to the FileService in
+const fileUploadChunkSize = 1024 * 1024
+
+// pattern_FileUpload_0 is /apis/v1beta1/{namespace}/files/upload
+var pattern_FileUpload_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 2, 4}, []string{"apis", "v1beta1", "namespace", "files", "upload"}, "", runtime.AssumeColonVerbOpt(true)))
+
+// RegisterFileUploadHandlerFromEndpoint registers the multipart upload handler of the FileService to "mux".
+// The gateway can't generate it as UploadArtifact is a client stream, the "file" parts of the form are streamed to it as they are read.
+// Their keys are the "prefix" query parameter followed by their file names.
+func RegisterFileUploadHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		if cerr := conn.Close(); cerr != nil {
+			grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+		}
+	}()
+
+	client := api.NewFileServiceClient(conn)
+	mux.Handle("POST", pattern_FileUpload_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		resp, err := uploadMultipartFiles(rctx, client, req, pathParams["namespace"])
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
+	return nil
+}
+
+// uploadMultipartFiles streams the "file" parts of the multipart form of the request to UploadArtifact
+func uploadMultipartFiles(ctx context.Context, client api.FileServiceClient, req *http.Request, namespace string) (*api.UploadArtifactResponse, error) {
+	reader, err := req.MultipartReader()
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Request must be a multipart form.")
+	}
+
+	prefix := req.URL.Query().Get("prefix")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	stream, err := client.UploadArtifact(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := make([]byte, fileUploadChunkSize)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Unable to read multipart form.")
+		}
+		if part.FormName() != "file" || part.FileName() == "" {
+			continue
+		}
+
+		uploadReq := &api.UploadArtifactRequest{
+			Namespace:   namespace,
+			Key:         prefix + part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+		}
+		for {
+			n, readErr := part.Read(chunk)
+			if n > 0 || uploadReq.Key != "" {
+				uploadReq.Chunk = chunk[:n]
+				// io.EOF means the server ended the stream, CloseAndRecv returns its error
+				if err := stream.Send(uploadReq); err == io.EOF {
+					return stream.CloseAndRecv()
+				} else if err != nil {
+					return nil, err
+				}
+				uploadReq = &api.UploadArtifactRequest{}
+			}
+			if readErr == io.EOF {
+				break
+			}
+			if readErr != nil {
+				return nil, util.NewUserError(codes.InvalidArgument, "Unable to read multipart form.")
+			}
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
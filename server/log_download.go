@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/onepanelio/core/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/grpclog"
+)
+
+// logChunkSize is the size of the chunks logs are streamed in
+const logChunkSize = 256 * 1024
+
+// pattern_LogDownload_0 is /apis/v1beta1/{namespace}/workflow_executions/{uid}/pods/{podName}/containers/{containerName}/logs/download
+var pattern_LogDownload_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 1, 0, 4, 1, 5, 2, 2, 3, 1, 0, 4, 1, 5, 4, 2, 5, 1, 0, 4, 1, 5, 6, 2, 7, 1, 0, 4, 1, 5, 8, 2, 9, 2, 10}, []string{"apis", "v1beta1", "namespace", "workflow_executions", "uid", "pods", "podName", "containers", "containerName", "logs", "download"}, "", runtime.AssumeColonVerbOpt(true)))
+
+// RegisterLogDownloadHandlerFromEndpoint registers the log download handler of the WorkflowService to "mux".
+// It writes the chunks of DownloadWorkflowExecutionLog as the plain text body of the response.
+func RegisterLogDownloadHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		if cerr := conn.Close(); cerr != nil {
+			grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+		}
+	}()
+
+	client := api.NewWorkflowServiceClient(conn)
+	mux.Handle("GET", pattern_LogDownload_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		stream, err := client.DownloadWorkflowExecutionLog(rctx, &api.DownloadWorkflowExecutionLogRequest{
+			Namespace:     pathParams["namespace"],
+			Uid:           pathParams["uid"],
+			PodName:       pathParams["podName"],
+			ContainerName: pathParams["containerName"],
+		})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		// Errors of the request are returned instead of the first chunk, an empty log has no chunks
+		chunk, err := stream.Recv()
+		if err != nil && err != io.EOF {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+
+		for err == nil {
+			if _, writeErr := w.Write(chunk.Data); writeErr != nil {
+				return
+			}
+			chunk, err = stream.Recv()
+		}
+		if err != io.EOF {
+			// The status is already sent, the client sees a truncated log
+			grpclog.Infof("Failed to stream log: %v", err)
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+)
+
+// NotificationServer contains actions for notification subscriptions.
+// Subscriptions aren't kubernetes resources, access is granted with RBAC rules for the "notificationsubscriptions" resource in the "onepanel.io" group.
+type NotificationServer struct{}
+
+// NewNotificationServer creates a new NotificationServer
+func NewNotificationServer() *NotificationServer {
+	return &NotificationServer{}
+}
+
+// CreateNotificationSubscription creates a subscription, the response is the only one that includes its secret
+func (s *NotificationServer) CreateNotificationSubscription(ctx context.Context, req *api.CreateNotificationSubscriptionRequest) (*api.NotificationSubscription, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "onepanel.io", "notificationsubscriptions", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Subscription == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Subscription is missing.")
+	}
+
+	subscription, err := client.CreateNotificationSubscription(&v1.NotificationSubscription{
+		Namespace: req.Namespace,
+		Name:      req.Subscription.Name,
+		URL:       req.Subscription.Url,
+		Secret:    req.Subscription.Secret,
+		Format:    req.Subscription.Format,
+		Events:    req.Subscription.Events,
+		Labels:    converter.APIKeyValueToLabel(req.Subscription.Labels),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.NotificationSubscriptionToAPI(subscription, true), nil
+}
+
+// ListNotificationSubscriptions returns the subscriptions of the namespace, newest first
+func (s *NotificationServer) ListNotificationSubscriptions(ctx context.Context, req *api.ListNotificationSubscriptionsRequest) (*api.ListNotificationSubscriptionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "onepanel.io", "notificationsubscriptions", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	subscriptions, err := client.ListNotificationSubscriptions(req.Namespace, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	apiSubscriptions := make([]*api.NotificationSubscription, 0)
+	for _, subscription := range subscriptions {
+		apiSubscriptions = append(apiSubscriptions, converter.NotificationSubscriptionToAPI(subscription, false))
+	}
+
+	count, err := client.CountNotificationSubscriptions(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListNotificationSubscriptionsResponse{
+		Count:         int32(len(apiSubscriptions)),
+		Subscriptions: apiSubscriptions,
+		Page:          int32(paginator.Page),
+		Pages:         paginator.CalculatePages(count),
+		TotalCount:    int32(count),
+	}, nil
+}
+
+// GetNotificationSubscription returns the subscription without its secret
+func (s *NotificationServer) GetNotificationSubscription(ctx context.Context, req *api.GetNotificationSubscriptionRequest) (*api.NotificationSubscription, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "notificationsubscriptions", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	subscription, err := client.GetNotificationSubscription(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.NotificationSubscriptionToAPI(subscription, false), nil
+}
+
+// DeleteNotificationSubscription deletes the subscription and its delivery log
+func (s *NotificationServer) DeleteNotificationSubscription(ctx context.Context, req *api.DeleteNotificationSubscriptionRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "onepanel.io", "notificationsubscriptions", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.DeleteNotificationSubscription(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListNotificationDeliveries returns the delivery log of the subscription, newest first
+func (s *NotificationServer) ListNotificationDeliveries(ctx context.Context, req *api.ListNotificationDeliveriesRequest) (*api.ListNotificationDeliveriesResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "notificationsubscriptions", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	subscription, err := client.GetNotificationSubscription(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	deliveries, err := client.ListNotificationDeliveries(subscription, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	apiDeliveries := make([]*api.NotificationDelivery, 0)
+	for _, delivery := range deliveries {
+		apiDeliveries = append(apiDeliveries, converter.NotificationDeliveryToAPI(delivery))
+	}
+
+	count, err := client.CountNotificationDeliveries(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListNotificationDeliveriesResponse{
+		Count:      int32(len(apiDeliveries)),
+		Deliveries: apiDeliveries,
+		Page:       int32(paginator.Page),
+		Pages:      paginator.CalculatePages(count),
+		TotalCount: int32(count),
+	}, nil
+}
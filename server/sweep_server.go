@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+)
+
+// SweepServer contains actions for sweeps
+type SweepServer struct{}
+
+// NewSweepServer creates a new SweepServer
+func NewSweepServer() *SweepServer {
+	return &SweepServer{}
+}
+
+// CreateSweep creates a sweep and launches its first runs.
+// Sweeps launch workflow executions, so access is granted with the RBAC rules for workflows.
+func (s *SweepServer) CreateSweep(ctx context.Context, req *api.CreateSweepRequest) (*api.Sweep, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Body == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Sweep is missing.")
+	}
+
+	sweep := &v1.Sweep{
+		Name: req.Body.Name,
+		WorkflowTemplate: &v1.WorkflowTemplate{
+			UID:     req.Body.WorkflowTemplateUid,
+			Version: req.Body.WorkflowTemplateVersion,
+		},
+		Spec: converter.APISweepSpecToInternal(req.Body.Spec),
+	}
+
+	sweep, err = client.CreateSweep(req.Namespace, sweep)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.SweepToAPI(sweep, true), nil
+}
+
+// ListSweeps returns the sweeps of the namespace, newest first
+func (s *SweepServer) ListSweeps(ctx context.Context, req *api.ListSweepsRequest) (*api.ListSweepsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	sweeps, err := client.ListSweeps(req.Namespace, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	apiSweeps := make([]*api.Sweep, 0)
+	for _, sweep := range sweeps {
+		apiSweeps = append(apiSweeps, converter.SweepToAPI(sweep, false))
+	}
+
+	count, err := client.CountSweeps(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListSweepsResponse{
+		Count:      int32(len(apiSweeps)),
+		Sweeps:     apiSweeps,
+		Page:       int32(paginator.Page),
+		Pages:      paginator.CalculatePages(count),
+		TotalCount: int32(count),
+	}, nil
+}
+
+// GetSweep returns the sweep with its runs
+func (s *SweepServer) GetSweep(ctx context.Context, req *api.GetSweepRequest) (*api.Sweep, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	sweep, err := client.GetSweep(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.SweepToAPI(sweep, true), nil
+}
+
+// StopSweep stops the sweep and terminates its runs that have not finished
+func (s *SweepServer) StopSweep(ctx context.Context, req *api.StopSweepRequest) (*api.Sweep, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	sweep, err := client.StopSweep(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.SweepToAPI(sweep, true), nil
+}
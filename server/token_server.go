@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+)
+
+// TokenServer contains actions for the API tokens of the current user
+type TokenServer struct{}
+
+// NewTokenServer creates a new TokenServer
+func NewTokenServer() *TokenServer {
+	return &TokenServer{}
+}
+
+// scopeToken limits the token to the scope of the token used to make the request, if any,
+// so a token can't be used to create a token with more access than itself.
+func scopeToken(token *v1.APIToken, parent *v1.APIToken) error {
+	if parent == nil {
+		return nil
+	}
+
+	if parent.ReadOnly {
+		token.ReadOnly = true
+	}
+
+	if parent.Namespace != "" {
+		if token.Namespace != "" && token.Namespace != parent.Namespace {
+			return util.NewUserError(codes.PermissionDenied, "Token can't be created for a namespace outside of the current token's namespace.")
+		}
+		token.Namespace = parent.Namespace
+	}
+
+	if parent.ExpiresAt != nil && (token.ExpiresAt == nil || token.ExpiresAt.After(*parent.ExpiresAt)) {
+		token.ExpiresAt = parent.ExpiresAt
+	}
+
+	return nil
+}
+
+// CreateToken creates an API token that acts as the current user
+func (s *TokenServer) CreateToken(ctx context.Context, req *api.CreateTokenRequest) (*api.CreateTokenResponse, error) {
+	if req.Token == nil || req.Token.Name == "" {
+		return nil, util.NewUserError(codes.InvalidArgument, "Token name is required.")
+	}
+
+	client := getClient(ctx)
+	identity, err := auth.ResolveIdentity(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &v1.APIToken{
+		Name:      req.Token.Name,
+		Username:  identity.Username,
+		Groups:    identity.Groups,
+		ReadOnly:  req.Token.ReadOnly,
+		Namespace: req.Token.Namespace,
+	}
+
+	if req.Token.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, req.Token.ExpiresAt)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Token expiry must be in RFC3339 format.")
+		}
+		expiresAt = expiresAt.UTC()
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := scopeToken(token, client.Token); err != nil {
+		return nil, err
+	}
+
+	secret, err := client.CreateAPIToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateTokenResponse{
+		Token:  converter.APITokenToAPI(token),
+		Secret: secret,
+	}, nil
+}
+
+// ListTokens returns the API tokens of the current user, including revoked and expired tokens
+func (s *TokenServer) ListTokens(ctx context.Context, req *api.ListTokensRequest) (*api.ListTokensResponse, error) {
+	client := getClient(ctx)
+	identity, err := auth.ResolveIdentity(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	tokens, err := client.ListAPITokens(identity.Username, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTokens := make([]*api.Token, 0)
+	for _, token := range tokens {
+		apiTokens = append(apiTokens, converter.APITokenToAPI(token))
+	}
+
+	count, err := client.CountAPITokens(identity.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListTokensResponse{
+		Count:      int32(len(apiTokens)),
+		Tokens:     apiTokens,
+		Page:       int32(paginator.Page),
+		Pages:      paginator.CalculatePages(count),
+		TotalCount: int32(count),
+	}, nil
+}
+
+// RevokeToken revokes an API token of the current user.
+// Like scopeToken, a token limited to a namespace can only revoke tokens of that namespace.
+func (s *TokenServer) RevokeToken(ctx context.Context, req *api.RevokeTokenRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	identity, err := auth.ResolveIdentity(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := ""
+	if client.Token != nil {
+		if client.Token.ReadOnly {
+			return nil, util.NewUserError(codes.PermissionDenied, "A read only token can't revoke tokens.")
+		}
+		namespace = client.Token.Namespace
+	}
+
+	if err := client.RevokeAPIToken(identity.Username, req.Uid, namespace); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
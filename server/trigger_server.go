@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/onepanelio/core/api"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/onepanelio/core/pkg/util/router"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// triggerSignatureMetadataKey is the metadata key that the gateway forwards the signature header of an invocation as
+const triggerSignatureMetadataKey = "x-onepanel-signature"
+
+// TriggerServer contains actions for triggers.
+// Triggers aren't kubernetes resources, access is granted with RBAC rules for the "triggers" resource in the "onepanel.io" group.
+type TriggerServer struct{}
+
+// NewTriggerServer creates a new TriggerServer
+func NewTriggerServer() *TriggerServer {
+	return &TriggerServer{}
+}
+
+// CreateTrigger creates a trigger and returns its secret url, artifact triggers have no url.
+// Invoking the trigger creates workflow executions with the server's credentials,
+// so the caller must also be allowed to create workflows in the namespace.
+func (s *TriggerServer) CreateTrigger(ctx context.Context, req *api.CreateTriggerRequest) (*api.CreateTriggerResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "onepanel.io", "triggers", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Trigger == nil || req.Trigger.WorkflowTemplate == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Trigger and its workflow template are required.")
+	}
+
+	trigger, credentials, err := client.CreateTrigger(&v1.Trigger{
+		Namespace: req.Namespace,
+		Name:      req.Trigger.Name,
+		WorkflowTemplate: &v1.WorkflowTemplate{
+			UID:     req.Trigger.WorkflowTemplate.Uid,
+			Version: req.Trigger.WorkflowTemplate.Version,
+		},
+		Parameters:     converter.APITriggerParametersToInternal(req.Trigger.Parameters),
+		Labels:         converter.APIKeyValueToLabel(req.Trigger.Labels),
+		Type:           req.Trigger.Type,
+		ArtifactPrefix: req.Trigger.ArtifactPrefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.CreateTriggerResponse{
+		Trigger: converter.TriggerToAPI(trigger),
+	}
+	if credentials == nil {
+		return resp, nil
+	}
+
+	apiRouter, err := client.GetAPIRouter()
+	if err != nil {
+		apiRouter, _ = router.NewRelativeAPIRouter()
+	}
+	resp.Url = apiRouter.InvokeTrigger(trigger.Namespace, trigger.UID, credentials.Token)
+	resp.Token = credentials.Token
+	resp.SigningKey = credentials.SigningKey
+
+	return resp, nil
+}
+
+// ListTriggers returns the triggers of the namespace, newest first
+func (s *TriggerServer) ListTriggers(ctx context.Context, req *api.ListTriggersRequest) (*api.ListTriggersResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "onepanel.io", "triggers", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	triggers, err := client.ListTriggers(req.Namespace, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	apiTriggers := make([]*api.Trigger, 0)
+	for _, trigger := range triggers {
+		apiTriggers = append(apiTriggers, converter.TriggerToAPI(trigger))
+	}
+
+	count, err := client.CountTriggers(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListTriggersResponse{
+		Count:      int32(len(apiTriggers)),
+		Triggers:   apiTriggers,
+		Page:       int32(paginator.Page),
+		Pages:      paginator.CalculatePages(count),
+		TotalCount: int32(count),
+	}, nil
+}
+
+// GetTrigger returns the trigger without its secrets
+func (s *TriggerServer) GetTrigger(ctx context.Context, req *api.GetTriggerRequest) (*api.Trigger, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "triggers", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	trigger, err := client.GetTrigger(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.TriggerToAPI(trigger), nil
+}
+
+// DeleteTrigger deletes the trigger and its invocations
+func (s *TriggerServer) DeleteTrigger(ctx context.Context, req *api.DeleteTriggerRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "onepanel.io", "triggers", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.DeleteTrigger(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListTriggerInvocations returns the invocations of the trigger, newest first
+func (s *TriggerServer) ListTriggerInvocations(ctx context.Context, req *api.ListTriggerInvocationsRequest) (*api.ListTriggerInvocationsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "triggers", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	trigger, err := client.GetTrigger(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	invocations, err := client.ListTriggerInvocations(trigger, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	apiInvocations := make([]*api.TriggerInvocation, 0)
+	for _, invocation := range invocations {
+		apiInvocations = append(apiInvocations, converter.TriggerInvocationToAPI(invocation))
+	}
+
+	count, err := client.CountTriggerInvocations(trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListTriggerInvocationsResponse{
+		Count:       int32(len(apiInvocations)),
+		Invocations: apiInvocations,
+		Page:        int32(paginator.Page),
+		Pages:       paginator.CalculatePages(count),
+		TotalCount:  int32(count),
+	}, nil
+}
+
+// InvokeTrigger creates a workflow execution from the payload.
+// The client has the server's credentials, see auth.UnaryInterceptor, the token in the url is what authenticates the request.
+func (s *TriggerServer) InvokeTrigger(ctx context.Context, req *api.InvokeTriggerRequest) (*api.TriggerInvocation, error) {
+	client := getClient(ctx)
+
+	var payload []byte
+	if req.Body != nil {
+		payload = req.Body.Data
+	}
+
+	signature := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(triggerSignatureMetadataKey); len(values) > 0 {
+			signature = values[0]
+		}
+	}
+
+	invocation, err := client.InvokeTrigger(req.Namespace, req.Uid, req.Token, payload, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.TriggerInvocationToAPI(invocation), nil
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/onepanelio/core/pkg/util/request/pagination"
 	"github.com/onepanelio/core/pkg/util/router"
 	"github.com/onepanelio/core/server/converter"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
+	"io"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sort"
 	"strings"
@@ -34,12 +36,14 @@ func NewWorkflowServer() *WorkflowServer {
 // router is optional
 func apiWorkflowExecution(wf *v1.WorkflowExecution, router router.Web) (workflow *api.WorkflowExecution) {
 	workflow = &api.WorkflowExecution{
-		CreatedAt: wf.CreatedAt.Format(time.RFC3339),
-		Uid:       wf.UID,
-		Name:      wf.Name,
-		Phase:     string(wf.Phase),
-		Manifest:  wf.Manifest,
-		Labels:    converter.MappingToKeyValue(wf.Labels),
+		CreatedAt:     wf.CreatedAt.Format(time.RFC3339),
+		Uid:           wf.UID,
+		Name:          wf.Name,
+		Phase:         string(wf.Phase),
+		Manifest:      wf.Manifest,
+		Labels:        converter.MappingToKeyValue(wf.Labels),
+		Priority:      wf.Priority,
+		QueuePosition: int32(wf.QueuePosition),
 	}
 
 	if wf.StartedAt != nil && !wf.StartedAt.IsZero() {
@@ -62,7 +66,8 @@ func apiWorkflowExecution(wf *v1.WorkflowExecution, router router.Web) (workflow
 
 	if router != nil {
 		workflow.Metadata = &api.WorkflowExecutionMetadata{
-			Url: router.WorkflowExecution(wf.Namespace, wf.UID),
+			Url:             router.WorkflowExecution(wf.Namespace, wf.UID),
+			ResourceVersion: wf.ResourceVersion,
 		}
 	}
 
@@ -75,9 +80,17 @@ func (s *WorkflowServer) CreateWorkflowExecution(ctx context.Context, req *api.C
 	if err != nil || !allowed {
 		return nil, err
 	}
+	// A positive priority puts the execution ahead of others in the execution queue
+	if req.Body.Priority > 0 {
+		allowed, err = auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workflowpriorities", "")
+		if err != nil || !allowed {
+			return nil, err
+		}
+	}
 
 	workflow := &v1.WorkflowExecution{
-		Labels: converter.APIKeyValueToLabel(req.Body.Labels),
+		Labels:   converter.APIKeyValueToLabel(req.Body.Labels),
+		Priority: req.Body.Priority,
 		WorkflowTemplate: &v1.WorkflowTemplate{
 			UID:     req.Body.WorkflowTemplateUid,
 			Version: req.Body.WorkflowTemplateVersion,
@@ -149,6 +162,16 @@ func (s *WorkflowServer) AddWorkflowExecutionStatistics(ctx context.Context, req
 	if err != nil {
 		return &empty.Empty{}, err
 	}
+
+	// Keep the nodes so the graph can be viewed after argo removes the workflow
+	if err := client.SaveWorkflowExecutionManifest(req.Namespace, req.Uid, workflow); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": req.Namespace,
+			"UID":       req.Uid,
+			"Error":     err.Error(),
+		}).Error("Unable to save workflow manifest.")
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -194,6 +217,22 @@ func (s *WorkflowServer) GetWorkflowExecution(ctx context.Context, req *api.GetW
 	return apiWorkflowExecution(wf, webRouter), nil
 }
 
+// GetWorkflowExecutionGraph returns the nodes of a workflow execution with their phase, inputs and outputs
+func (s *WorkflowServer) GetWorkflowExecutionGraph(ctx context.Context, req *api.GetWorkflowExecutionGraphRequest) (*api.WorkflowExecutionGraph, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	graph, err := client.GetWorkflowExecutionGraph(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.WorkflowExecutionGraphToAPI(graph), nil
+}
+
 func (s *WorkflowServer) WatchWorkflowExecution(req *api.WatchWorkflowExecutionRequest, stream api.WorkflowService_WatchWorkflowExecutionServer) error {
 	client := getClient(stream.Context())
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -201,7 +240,7 @@ func (s *WorkflowServer) WatchWorkflowExecution(req *api.WatchWorkflowExecutionR
 		return err
 	}
 
-	watcher, err := client.WatchWorkflowExecution(req.Namespace, req.Uid)
+	watcher, err := client.WatchWorkflowExecution(req.Namespace, req.Uid, req.ResourceVersion, stream.Context().Done())
 	if err != nil {
 		return err
 	}
@@ -224,6 +263,84 @@ func (s *WorkflowServer) WatchWorkflowExecution(req *api.WatchWorkflowExecutionR
 	return nil
 }
 
+// WatchWorkflowExecutions streams the changes of the workflow executions in the namespace, with a heartbeat every v1.WatchHeartbeatInterval
+func (s *WorkflowServer) WatchWorkflowExecutions(req *api.WatchWorkflowExecutionsRequest, stream api.WorkflowService_WatchWorkflowExecutionsServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "watch", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return err
+	}
+
+	labelFilter, err := v1.LabelsFromString(req.Labels)
+	if err != nil {
+		return err
+	}
+
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return err
+	}
+
+	watcher, err := client.WatchWorkflowExecutions(req.Namespace, &v1.WatchFilter{
+		Labels: labelFilter,
+		Phase:  req.Phase,
+	}, req.ResourceVersion, stream.Context().Done())
+	if err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(v1.WatchHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	resourceVersion := req.ResourceVersion
+	for {
+		event := &api.WorkflowExecutionWatchEvent{
+			Type:            v1.WatchEventHeartbeat,
+			ResourceVersion: resourceVersion,
+		}
+
+		select {
+		case <-heartbeat.C:
+		case <-stream.Context().Done():
+			return nil
+		case next, ok := <-watcher:
+			if !ok {
+				return nil
+			}
+			if next.Err != nil {
+				return next.Err
+			}
+			resourceVersion = next.ResourceVersion
+			event.Type = next.Type
+			event.ResourceVersion = next.ResourceVersion
+			event.WorkflowExecution = apiWorkflowExecution(next.WorkflowExecution, webRouter)
+		}
+
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+}
+
+// getLogOptions returns the LogOptions for the request parameters, sinceTime is a RFC3339 timestamp
+func getLogOptions(tailLines int64, sinceTime, filter string, filterRegex bool) (*v1.LogOptions, error) {
+	opts := &v1.LogOptions{
+		TailLines:   tailLines,
+		Filter:      filter,
+		FilterRegex: filterRegex,
+	}
+
+	if sinceTime != "" {
+		since, err := time.Parse(time.RFC3339, sinceTime)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "sinceTime must be a RFC3339 timestamp.")
+		}
+		opts.Since = &since
+	}
+
+	return opts, nil
+}
+
 func (s *WorkflowServer) GetWorkflowExecutionLogs(req *api.GetWorkflowExecutionLogsRequest, stream api.WorkflowService_GetWorkflowExecutionLogsServer) error {
 	client := getClient(stream.Context())
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -231,7 +348,16 @@ func (s *WorkflowServer) GetWorkflowExecutionLogs(req *api.GetWorkflowExecutionL
 		return err
 	}
 
-	watcher, err := client.GetWorkflowExecutionLogs(req.Namespace, req.Uid, req.PodName, req.ContainerName)
+	if req.TailLines < 0 {
+		return util.NewUserError(codes.InvalidArgument, "tailLines can't be negative.")
+	}
+
+	opts, err := getLogOptions(req.TailLines, req.SinceTime, req.Filter, req.FilterRegex)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := client.GetWorkflowExecutionLogs(stream.Context(), req.Namespace, req.Uid, req.PodName, req.ContainerName, opts)
 	if err != nil {
 		return err
 	}
@@ -254,6 +380,104 @@ func (s *WorkflowServer) GetWorkflowExecutionLogs(req *api.GetWorkflowExecutionL
 	return nil
 }
 
+func (s *WorkflowServer) GetWorkflowExecutionAggregatedLogs(req *api.GetWorkflowExecutionAggregatedLogsRequest, stream api.WorkflowService_GetWorkflowExecutionAggregatedLogsServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	if req.TailLines < 0 {
+		return util.NewUserError(codes.InvalidArgument, "tailLines can't be negative.")
+	}
+
+	opts, err := getLogOptions(req.TailLines, req.SinceTime, req.Filter, req.FilterRegex)
+	if err != nil {
+		return err
+	}
+
+	watcher, err := client.GetWorkflowExecutionAggregatedLogs(stream.Context(), req.Namespace, req.Uid, opts)
+	if err != nil {
+		return err
+	}
+
+	for le := range watcher {
+		if err := stream.Send(&api.LogEntry{
+			Timestamp:     le.Timestamp.String(),
+			Content:       le.Content,
+			NodeName:      le.NodeName,
+			PodName:       le.PodName,
+			ContainerName: le.ContainerName,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return stream.Context().Err()
+}
+
+func (s *WorkflowServer) GetWorkflowExecutionLogPage(ctx context.Context, req *api.GetWorkflowExecutionLogPageRequest) (*api.GetWorkflowExecutionLogPageResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	opts, err := getLogOptions(0, req.SinceTime, req.Filter, req.FilterRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := client.GetWorkflowExecutionLogPage(req.Namespace, req.Uid, req.PodName, req.ContainerName, req.Offset, req.Limit, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.GetWorkflowExecutionLogPageResponse{
+		NextOffset: page.NextOffset,
+		Done:       page.Done,
+	}
+	for _, le := range page.Entries {
+		res.Entries = append(res.Entries, &api.LogEntry{
+			Timestamp: le.Timestamp.String(),
+			Content:   le.Content,
+		})
+	}
+
+	return res, nil
+}
+
+// DownloadWorkflowExecutionLog sends the complete log of the container in chunks, so it is never held in memory
+func (s *WorkflowServer) DownloadWorkflowExecutionLog(req *api.DownloadWorkflowExecutionLogRequest, stream api.WorkflowService_DownloadWorkflowExecutionLogServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	reader, err := client.GetWorkflowExecutionLogFile(req.Namespace, req.Uid, req.PodName, req.ContainerName)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	chunk := make([]byte, logChunkSize)
+	for {
+		n, readErr := io.ReadFull(reader, chunk)
+		if n > 0 {
+			if err := stream.Send(&api.LogChunk{Data: chunk[:n]}); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if readErr != nil {
+			return util.NewUserError(codes.Unknown, "Unable to read log.")
+		}
+	}
+}
+
 func (s *WorkflowServer) GetWorkflowExecutionMetrics(ctx context.Context, req *api.GetWorkflowExecutionMetricsRequest) (*api.GetWorkflowExecutionMetricsResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -374,6 +598,73 @@ func (s *WorkflowServer) TerminateWorkflowExecution(ctx context.Context, req *ap
 	return &empty.Empty{}, nil
 }
 
+// RetryWorkflowExecution runs the failed nodes of a workflow execution again, or restarts it from a node
+func (s *WorkflowServer) RetryWorkflowExecution(ctx context.Context, req *api.RetryWorkflowExecutionRequest) (*api.WorkflowExecution, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	wf, err := client.RetryWorkflowExecution(req.Namespace, req.Uid, &v1.RetryWorkflowExecutionOptions{
+		RestartNodeID: req.RestartNodeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if wf == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found")
+	}
+
+	wf.Namespace = req.Namespace
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecution(wf, webRouter), nil
+}
+
+// SuspendWorkflowExecution suspends a running workflow execution
+func (s *WorkflowServer) SuspendWorkflowExecution(ctx context.Context, req *api.SuspendWorkflowExecutionRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.SuspendWorkflowExecution(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ResumeWorkflowExecution resumes a suspended workflow execution
+func (s *WorkflowServer) ResumeWorkflowExecution(ctx context.Context, req *api.ResumeWorkflowExecutionRequest) (*api.WorkflowExecution, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	wf, err := client.ResumeWorkflowExecution(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	if wf == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found")
+	}
+
+	wf.Namespace = req.Namespace
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecution(wf, webRouter), nil
+}
+
 func (s *WorkflowServer) GetArtifact(ctx context.Context, req *api.GetArtifactRequest) (*api.ArtifactResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -391,6 +682,51 @@ func (s *WorkflowServer) GetArtifact(ctx context.Context, req *api.GetArtifactRe
 	}, nil
 }
 
+// GetArtifactStream sends the artifact in chunks, after a chunk with its content type, size and the range that is sent
+func (s *WorkflowServer) GetArtifactStream(req *api.GetArtifactStreamRequest, stream api.WorkflowService_GetArtifactStreamServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	reader, err := client.GetArtifactRange(req.Namespace, req.Uid, req.Key, req.Offset, req.Length)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	contentType := reader.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	err = stream.Send(&api.ArtifactChunk{
+		ContentType: contentType,
+		Size:        reader.Size,
+		Offset:      reader.Offset,
+		Length:      reader.Length,
+	})
+	if err != nil {
+		return err
+	}
+
+	chunk := make([]byte, artifactChunkSize)
+	for {
+		n, readErr := io.ReadFull(reader, chunk)
+		if n > 0 {
+			if err := stream.Send(&api.ArtifactChunk{Data: chunk[:n]}); err != nil {
+				return err
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if readErr != nil {
+			return util.NewUserError(codes.Unavailable, "Unable to read artifact.")
+		}
+	}
+}
+
 func (s *WorkflowServer) ListFiles(ctx context.Context, req *api.ListFilesRequest) (*api.ListFilesResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -468,3 +804,203 @@ func (s *WorkflowServer) GetWorkflowExecutionStatisticsForNamespace(ctx context.
 		Stats: converter.WorkflowExecutionStatisticsReportToAPI(report),
 	}, nil
 }
+
+// ListQueuedWorkflowExecutions returns the queued workflow executions in the order they are dispatched
+func (s *WorkflowServer) ListQueuedWorkflowExecutions(ctx context.Context, req *api.ListQueuedWorkflowExecutionsRequest) (*api.ListQueuedWorkflowExecutionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	workflows, err := client.ListQueuedWorkflowExecutions(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListQueuedWorkflowExecutionsResponse{}
+	for _, wf := range workflows {
+		wf.Namespace = req.Namespace
+		res.WorkflowExecutions = append(res.WorkflowExecutions, apiWorkflowExecution(wf, webRouter))
+	}
+
+	return res, nil
+}
+
+// getWorkflowExecutionSelector converts the api selector, which has the same criteria as ListWorkflowExecutionsRequest
+func getWorkflowExecutionSelector(selector *api.WorkflowExecutionSelector) (*v1.WorkflowExecutionSelector, error) {
+	if selector == nil {
+		selector = &api.WorkflowExecutionSelector{}
+	}
+
+	labelFilter, err := v1.LabelsFromString(selector.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.WorkflowExecutionSelector{
+		WorkflowTemplateUID:     selector.WorkflowTemplateUid,
+		WorkflowTemplateVersion: selector.WorkflowTemplateVersion,
+		IncludeSystem:           selector.IncludeSystem,
+		Filter: v1.WorkflowExecutionFilter{
+			Labels: labelFilter,
+			Phase:  selector.Phase,
+		},
+	}, nil
+}
+
+// BulkTerminateWorkflowExecutions terminates the running workflow executions matched by the selector
+func (s *WorkflowServer) BulkTerminateWorkflowExecutions(ctx context.Context, req *api.BulkWorkflowExecutionsRequest) (*api.BulkWorkflowExecutionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	selector, err := getWorkflowExecutionSelector(req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := client.BulkTerminateWorkflowExecutions(req.Namespace, selector, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.BulkWorkflowExecutionResultsToAPI(results, req.DryRun), nil
+}
+
+// BulkArchiveWorkflowExecutions archives the workflow executions matched by the selector
+func (s *WorkflowServer) BulkArchiveWorkflowExecutions(ctx context.Context, req *api.BulkWorkflowExecutionsRequest) (*api.BulkWorkflowExecutionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "delete", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	selector, err := getWorkflowExecutionSelector(req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := client.BulkArchiveWorkflowExecutions(req.Namespace, selector, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.BulkWorkflowExecutionResultsToAPI(results, req.DryRun), nil
+}
+
+// BulkLabelWorkflowExecutions adds labels to the workflow executions matched by the selector
+func (s *WorkflowServer) BulkLabelWorkflowExecutions(ctx context.Context, req *api.BulkLabelWorkflowExecutionsRequest) (*api.BulkWorkflowExecutionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	selector, err := getWorkflowExecutionSelector(req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyValues map[string]string
+	if req.Labels != nil {
+		keyValues = converter.APIKeyValueToLabel(req.Labels.Items)
+	}
+
+	results, err := client.BulkLabelWorkflowExecutions(req.Namespace, selector, keyValues, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.BulkWorkflowExecutionResultsToAPI(results, req.DryRun), nil
+}
+
+// GetWorkflowExecutionRetentionReport returns the workflow executions that the retention policies would archive now, without archiving them
+func (s *WorkflowServer) GetWorkflowExecutionRetentionReport(ctx context.Context, req *api.GetWorkflowExecutionRetentionReportRequest) (*api.GetWorkflowExecutionRetentionReportResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	items, err := client.GetRetentionReport(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.RetentionReportToAPI(items), nil
+}
+
+// CompareWorkflowExecutions returns the workflow executions side by side
+func (s *WorkflowServer) CompareWorkflowExecutions(ctx context.Context, req *api.CompareWorkflowExecutionsRequest) (*api.WorkflowExecutionComparison, error) {
+	client := getClient(ctx)
+	for _, uid := range req.Uids {
+		allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", uid)
+		if err != nil || !allowed {
+			return nil, err
+		}
+	}
+
+	comparison, err := client.CompareWorkflowExecutions(req.Namespace, req.Uids)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.WorkflowExecutionComparisonToAPI(comparison), nil
+}
+
+// LogMetrics stores metrics logged by a running step.
+// Steps authenticate with the token of their service account, which may update the workflow execution.
+func (s *WorkflowServer) LogMetrics(ctx context.Context, req *api.LogMetricsRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	err = client.LogWorkflowExecutionMetrics(req.Namespace, req.Uid, req.NodeId, converter.APIMetricPointsToInternal(req.Points))
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetMetricSeries returns the metrics logged by the steps of a workflow execution, downsampled to at most maxPoints per series
+func (s *WorkflowServer) GetMetricSeries(ctx context.Context, req *api.GetMetricSeriesRequest) (*api.GetMetricSeriesResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	series, err := client.GetWorkflowExecutionMetricSeries(req.Namespace, req.Uid, &v1.MetricSeriesOptions{
+		NodeID:    req.NodeId,
+		Names:     req.Names,
+		MaxPoints: int(req.MaxPoints),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.MetricSeriesToAPI(series), nil
+}
@@ -208,6 +208,65 @@ func (s *WorkspaceServer) UpdateWorkspace(ctx context.Context, req *api.UpdateWo
 	return &empty.Empty{}, err
 }
 
+// WatchWorkspaces streams the changes of the workspaces in the namespace, with a heartbeat every v1.WatchHeartbeatInterval
+func (s *WorkspaceServer) WatchWorkspaces(req *api.WatchWorkspacesRequest, stream api.WorkspaceService_WatchWorkspacesServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "watch", "onepanel.io", "workspaces", "")
+	if err != nil || !allowed {
+		return err
+	}
+
+	labelFilter, err := v1.LabelsFromString(req.Labels)
+	if err != nil {
+		return err
+	}
+
+	sysConfig, err := client.GetSystemConfig()
+	if err != nil {
+		return err
+	}
+
+	watcher, err := client.WatchWorkspaces(req.Namespace, &v1.WatchFilter{
+		Labels: labelFilter,
+		Phase:  req.Phase,
+	}, req.Cursor, stream.Context().Done())
+	if err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(v1.WatchHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	cursor := req.Cursor
+	for {
+		event := &api.WorkspaceWatchEvent{
+			Type:   v1.WatchEventHeartbeat,
+			Cursor: cursor,
+		}
+
+		select {
+		case <-heartbeat.C:
+		case <-stream.Context().Done():
+			return nil
+		case next, ok := <-watcher:
+			if !ok {
+				return nil
+			}
+			if next.Err != nil {
+				return next.Err
+			}
+			cursor = next.Cursor
+			event.Type = next.Type
+			event.Cursor = next.Cursor
+			event.Workspace = apiWorkspace(next.Workspace, sysConfig)
+		}
+
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+}
+
 func (s *WorkspaceServer) ListWorkspaces(ctx context.Context, req *api.ListWorkspaceRequest) (*api.ListWorkspaceResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "onepanel.io", "workspaces", "")